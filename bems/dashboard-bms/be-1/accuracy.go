@@ -0,0 +1,400 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+/* KODE PROGRAM - AKURASI SOFT SENSOR */
+
+// softSensorPrefix marks Parameter aliases written by the soft-sensor. The
+// physical counterpart of "ss_temperature" is "temperature" on the same site.
+const softSensorPrefix = "ss_"
+
+// accuracyTolerance is the maximum distance between a prediction and the
+// physical reading it is paired with.
+var accuracyTolerance = 5 * time.Minute
+
+type timedValue struct {
+	Time  time.Time
+	Value float64
+	Label string
+}
+
+// modelSelection is one row of the models table. selected_at is written by
+// be-2 through the driver default (UTC), unlike Value/Predict which store
+// Asia/Jakarta wall-clock time, so it is used as scanned.
+type modelSelection struct {
+	Model      string
+	SelectedAt time.Time
+}
+
+// activeModel returns the model selected most recently before t, or "" when
+// no model had been selected yet.
+func activeModel(timeline []modelSelection, t time.Time) string {
+	i := sort.Search(len(timeline), func(i int) bool { return timeline[i].SelectedAt.After(t) })
+	if i == 0 {
+		return ""
+	}
+	return timeline[i-1].Model
+}
+
+type softSensorSeries struct {
+	SiteId      string
+	SiteAlias   string
+	Parameter   string
+	Predictions []timedValue
+}
+
+// softSensorPredictions loads every ss_* prediction in [from, to), grouped
+// per site and physical parameter. An empty siteAlias selects every site.
+func softSensorPredictions(ctx context.Context, siteAlias string, from, to time.Time) ([]*softSensorSeries, error) {
+	var parameters []parameterRecord
+	var err error
+	if siteAlias != "" {
+		parameters, err = repo.Parameters.BySite(ctx, siteAlias)
+	} else {
+		parameters, err = repo.Parameters.List(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var series []*softSensorSeries
+	for _, p := range parameters {
+		if !strings.HasPrefix(p.Alias, softSensorPrefix) {
+			continue
+		}
+		predictions, err := repo.Predictions.Range(ctx, p.ID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if len(predictions) == 0 {
+			continue
+		}
+		series = append(series, &softSensorSeries{
+			SiteId:      p.SiteID,
+			SiteAlias:   p.SiteAlias,
+			Parameter:   strings.TrimPrefix(p.Alias, softSensorPrefix),
+			Predictions: predictions,
+		})
+	}
+	return series, nil
+}
+
+// physicalReadings loads the Value rows of one parameter of a site, ordered by
+// time.
+func physicalReadings(ctx context.Context, siteAlias, parameter string, from, to time.Time) ([]timedValue, error) {
+	p, err := repo.Parameters.Find(ctx, siteAlias, parameter)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return repo.Values.Range(ctx, p.ID, from, to)
+}
+
+// nearestReading finds the reading closest to t within tolerance. readings
+// must be sorted by time.
+func nearestReading(readings []timedValue, t time.Time, tolerance time.Duration) (timedValue, bool) {
+	i := sort.Search(len(readings), func(i int) bool { return !readings[i].Time.Before(t) })
+
+	best, found := timedValue{}, false
+	bestDistance := tolerance + 1
+	for _, j := range []int{i - 1, i} {
+		if j < 0 || j >= len(readings) {
+			continue
+		}
+		distance := readings[j].Time.Sub(t)
+		if distance < 0 {
+			distance = -distance
+		}
+		if distance <= tolerance && distance < bestDistance {
+			best, found, bestDistance = readings[j], true, distance
+		}
+	}
+	return best, found
+}
+
+type accuracyMetrics struct {
+	Site        string   `json:"site"`
+	Parameter   string   `json:"parameter"`
+	Model       string   `json:"model"`
+	PeriodStart string   `json:"periodStart"`
+	PeriodEnd   string   `json:"periodEnd"`
+	Samples     int      `json:"samples"`
+	MAE         float64  `json:"mae"`
+	RMSE        float64  `json:"rmse"`
+	Bias        float64  `json:"bias"`
+	R2          *float64 `json:"r2"`
+
+	siteId string
+	pairs  [][2]float64
+}
+
+func (m *accuracyMetrics) compute() {
+	m.Samples = len(m.pairs)
+	if m.Samples == 0 {
+		return
+	}
+
+	var sumAbs, sumSq, sumErr, sumActual float64
+	for _, pair := range m.pairs {
+		diff := pair[0] - pair[1]
+		sumAbs += math.Abs(diff)
+		sumSq += diff * diff
+		sumErr += diff
+		sumActual += pair[1]
+	}
+	n := float64(m.Samples)
+	m.MAE = sumAbs / n
+	m.RMSE = math.Sqrt(sumSq / n)
+	m.Bias = sumErr / n
+
+	// R² tidak terdefinisi bila nilai aktual konstan
+	mean := sumActual / n
+	var sumTot float64
+	for _, pair := range m.pairs {
+		sumTot += (pair[1] - mean) * (pair[1] - mean)
+	}
+	if sumTot > 0 {
+		r2 := 1 - sumSq/sumTot
+		m.R2 = &r2
+	}
+}
+
+// softSensorAccuracy pairs predictions with physical readings in [from, to)
+// and computes metrics per site, parameter and active model.
+func softSensorAccuracy(ctx context.Context, siteAlias string, from, to time.Time, tolerance time.Duration) ([]*accuracyMetrics, error) {
+	series, err := softSensorPredictions(ctx, siteAlias, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*accuracyMetrics
+	for _, s := range series {
+		readings, err := physicalReadings(ctx, s.SiteAlias, s.Parameter, from.Add(-tolerance), to.Add(tolerance))
+		if err != nil {
+			return nil, err
+		}
+		timeline, err := repo.Models.Selections(ctx, s.SiteId, s.Parameter)
+		if err != nil {
+			return nil, err
+		}
+
+		byModel := map[string]*accuracyMetrics{}
+		for _, prediction := range s.Predictions {
+			reading, ok := nearestReading(readings, prediction.Time, tolerance)
+			if !ok {
+				continue
+			}
+			model := activeModel(timeline, prediction.Time)
+			if byModel[model] == nil {
+				byModel[model] = &accuracyMetrics{
+					Site:        s.SiteAlias,
+					Parameter:   s.Parameter,
+					Model:       model,
+					PeriodStart: from.Format(time.RFC3339),
+					PeriodEnd:   to.Format(time.RFC3339),
+					siteId:      s.SiteId,
+				}
+				results = append(results, byModel[model])
+			}
+			byModel[model].pairs = append(byModel[model].pairs, [2]float64{prediction.Value, reading.Value})
+		}
+	}
+
+	for _, m := range results {
+		m.compute()
+	}
+	return results, nil
+}
+
+func getSoftSensorAccuracy(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseTimeRange(r, 24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	tolerance, err := parseDuration(r, "tolerance", accuracyTolerance)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	results, err := softSensorAccuracy(r.Context(), r.URL.Query().Get("site"), from, to, tolerance)
+	if err != nil {
+		log.Printf("Error computing soft-sensor accuracy: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menghitung akurasi soft sensor")
+		return
+	}
+
+	model := r.URL.Query().Get("model")
+	filtered := []*accuracyMetrics{}
+	for _, m := range results {
+		if model == "" || m.Model == model {
+			filtered = append(filtered, m)
+		}
+	}
+	writeJSON(w, http.StatusOK, filtered)
+}
+
+func getSoftSensorAccuracyHistory(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseTimeRange(r, 30*24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	query := `
+        SELECT si.alias, a.parameter, a.model, a.periodStart, a.periodEnd, a.samples, a.mae, a.rmse, a.bias, a.r2
+        FROM SoftSensorAccuracy a
+        JOIN Site si ON a.siteId = si.id
+        WHERE a.periodStart >= ? AND a.periodStart < ?`
+	args := []interface{}{from.Format(dbTimeLayout), to.Format(dbTimeLayout)}
+	for _, filter := range []struct{ param, column string }{
+		{"site", "si.alias"}, {"parameter", "a.parameter"}, {"model", "a.model"},
+	} {
+		if value := r.URL.Query().Get(filter.param); value != "" {
+			query += " AND " + filter.column + " = ?"
+			args = append(args, value)
+		}
+	}
+	query += " ORDER BY a.periodStart, si.alias, a.parameter"
+
+	rows, err := queryDB(r.Context(), "accuracy_history", query, args...)
+	if err != nil {
+		log.Printf("Error querying soft-sensor accuracy history: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil data dari database")
+		return
+	}
+	defer rows.Close()
+
+	results := []accuracyMetrics{}
+	for rows.Next() {
+		var m accuracyMetrics
+		var periodStart, periodEnd time.Time
+		var r2 sql.NullFloat64
+		if err := rows.Scan(&m.Site, &m.Parameter, &m.Model, &periodStart, &periodEnd, &m.Samples, &m.MAE, &m.RMSE, &m.Bias, &r2); err != nil {
+			log.Printf("Error scanning soft-sensor accuracy history: %v", err)
+			writeError(w, http.StatusInternalServerError, "Gagal membaca hasil database")
+			return
+		}
+		m.PeriodStart = localTime(periodStart).Format(time.RFC3339)
+		m.PeriodEnd = localTime(periodEnd).Format(time.RFC3339)
+		if r2.Valid {
+			m.R2 = &r2.Float64
+		}
+		results = append(results, m)
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+// accuracyCatchUp bounds how many past days one run evaluates after the job
+// was stopped, so a long outage does not stall startup.
+const accuracyCatchUp = 30
+
+// runAccuracyJob stores the accuracy of every completed day that has not been
+// evaluated yet, checking once per interval. It resumes from the day after the
+// last evaluated period, so days missed while the service was down are filled
+// in.
+func runAccuracyJob(ctx context.Context, interval time.Duration) {
+	for {
+		now := time.Now().In(indonesiaLocation)
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, indonesiaLocation)
+		start, err := nextAccuracyDay(ctx, today)
+		if err != nil {
+			log.Printf("Gagal membaca periode akurasi soft sensor terakhir: %v", err)
+		}
+		for ; err == nil && start.Before(today) && ctx.Err() == nil; start = start.AddDate(0, 0, 1) {
+			if err := storeSoftSensorAccuracy(ctx, start, start.AddDate(0, 0, 1)); err != nil {
+				log.Printf("Gagal menyimpan akurasi soft sensor %s: %v", start.Format("2006-01-02"), err)
+				break
+			}
+		}
+		if !sleepContext(ctx, interval) {
+			return
+		}
+	}
+}
+
+// nextAccuracyDay is the day after the last evaluated period, or yesterday
+// when nothing was evaluated yet, but no more than accuracyCatchUp days before
+// today. SoftSensorAccuracyRun records days without predictions too, which
+// store no SoftSensorAccuracy rows.
+func nextAccuracyDay(ctx context.Context, today time.Time) (time.Time, error) {
+	earliest := today.AddDate(0, 0, -accuracyCatchUp)
+	var last time.Time
+	err := queryRowDB(ctx, "accuracy_last_period", `SELECT periodStart FROM SoftSensorAccuracyRun ORDER BY periodStart DESC LIMIT 1`).Scan(&last)
+	if err == sql.ErrNoRows {
+		return today.AddDate(0, 0, -1), nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	last = localTime(last)
+	next := time.Date(last.Year(), last.Month(), last.Day()+1, 0, 0, 0, 0, indonesiaLocation)
+	if next.Before(earliest) {
+		return earliest, nil
+	}
+	return next, nil
+}
+
+// storeSoftSensorAccuracy evaluates one period and writes all of its rows,
+// together with the SoftSensorAccuracyRun marker, in a single transaction, so
+// a failure never leaves a day half stored.
+func storeSoftSensorAccuracy(ctx context.Context, start, end time.Time) error {
+	var count int
+	if err := queryRowDB(ctx, "accuracy_exists", `SELECT COUNT(*) FROM SoftSensorAccuracyRun WHERE periodStart = ?`, start.Format(dbTimeLayout)).Scan(&count); err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	results, err := softSensorAccuracy(ctx, "", start, end, accuracyTolerance)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	created := time.Now().In(indonesiaLocation).Format(dbTimeLayout)
+	for _, m := range results {
+		var r2 interface{}
+		if m.R2 != nil {
+			r2 = *m.R2
+		}
+		_, err := tx.ExecContext(ctx, `
+            INSERT INTO SoftSensorAccuracy (siteId, parameter, model, periodStart, periodEnd, samples, mae, rmse, bias, r2, created)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			m.siteId, m.Parameter, m.Model, start.Format(dbTimeLayout), end.Format(dbTimeLayout),
+			m.Samples, m.MAE, m.RMSE, m.Bias, r2, created)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO SoftSensorAccuracyRun (periodStart, periodEnd, models, created)
+        VALUES (?, ?, ?, ?)`,
+		start.Format(dbTimeLayout), end.Format(dbTimeLayout), len(results), created)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	log.Printf("Akurasi soft sensor %s tersimpan (%d model)", start.Format("2006-01-02"), len(results))
+	return nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAccuracyJobAdvancesPastEmptyDays(t *testing.T) {
+	testRepositories(t)
+	today := time.Date(2024, 5, 10, 0, 0, 0, 0, indonesiaLocation)
+
+	day := today.AddDate(0, 0, -3)
+	if err := storeSoftSensorAccuracy(context.Background(), day, day.AddDate(0, 0, 1)); err != nil {
+		t.Fatal(err)
+	}
+	next, err := nextAccuracyDay(context.Background(), today)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := day.AddDate(0, 0, 1); !next.Equal(want) {
+		t.Fatalf("hari berikutnya = %v, seharusnya %v meski hari tanpa prediksi", next, want)
+	}
+
+	// Evaluasi ulang hari yang sama tidak boleh gagal pada kunci primer
+	if err := storeSoftSensorAccuracy(context.Background(), day, day.AddDate(0, 0, 1)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,449 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	"platform"
+)
+
+/* KODE PROGRAM - SIKLUS HIDUP ALERT */
+
+const (
+	alertStatusTriggered    = "triggered"
+	alertStatusAcknowledged = "acknowledged"
+	alertStatusResolved     = "resolved"
+	alertStatusAutoResolved = "auto_resolved"
+)
+
+// Escalation raises the level of an alert that stays unacknowledged for
+// escalateAfter, at most maxEscalationLevel times.
+var (
+	escalateAfter      = 15 * time.Minute
+	maxEscalationLevel = 3
+)
+
+type alert struct {
+	ID              int64      `json:"id"`
+	RuleID          int64      `json:"ruleId"`
+	RuleName        string     `json:"ruleName"`
+	SiteAlias       string     `json:"siteAlias"`
+	Parameter       string     `json:"parameter"`
+	DeviceID        string     `json:"deviceId"`
+	Severity        string     `json:"severity"`
+	Status          string     `json:"status"`
+	Message         string     `json:"message"`
+	Note            string     `json:"note"`
+	Value           float64    `json:"value"`
+	Threshold       float64    `json:"threshold"`
+	TriggeredAt     time.Time  `json:"triggeredAt"`
+	AcknowledgedAt  *time.Time `json:"acknowledgedAt"`
+	AcknowledgedBy  string     `json:"acknowledgedBy"`
+	ResolvedAt      *time.Time `json:"resolvedAt"`
+	ResolvedBy      string     `json:"resolvedBy"`
+	EscalationLevel int        `json:"escalationLevel"`
+	EscalatedAt     *time.Time `json:"escalatedAt"`
+}
+
+const alertColumns = `id, ruleId, ruleName, siteAlias, parameter, deviceId, severity, status, message, note, value, threshold,
+        triggeredAt, acknowledgedAt, acknowledgedBy, resolvedAt, resolvedBy, escalationLevel, escalatedAt`
+
+func nullableTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	local := localTime(t.Time)
+	return &local
+}
+
+func scanAlert(row rowScanner) (alert, error) {
+	var a alert
+	var acknowledgedAt, resolvedAt, escalatedAt sql.NullTime
+	var note, acknowledgedBy, resolvedBy sql.NullString
+	err := row.Scan(&a.ID, &a.RuleID, &a.RuleName, &a.SiteAlias, &a.Parameter, &a.DeviceID, &a.Severity, &a.Status,
+		&a.Message, &note, &a.Value, &a.Threshold, &a.TriggeredAt, &acknowledgedAt, &acknowledgedBy, &resolvedAt, &resolvedBy,
+		&a.EscalationLevel, &escalatedAt)
+	a.Note = note.String
+	a.TriggeredAt = localTime(a.TriggeredAt)
+	a.AcknowledgedAt = nullableTime(acknowledgedAt)
+	a.AcknowledgedBy = acknowledgedBy.String
+	a.ResolvedAt = nullableTime(resolvedAt)
+	a.ResolvedBy = resolvedBy.String
+	a.EscalatedAt = nullableTime(escalatedAt)
+	return a, err
+}
+
+func queryAlerts(query string, args ...interface{}) ([]alert, error) {
+	rows, err := queryDB(context.Background(), "alerts", query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	alerts := []alert{}
+	for rows.Next() {
+		a, err := scanAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, a)
+	}
+	return alerts, rows.Err()
+}
+
+func getAlertByID(id int64) (alert, error) {
+	return scanAlert(queryRowDB(context.Background(), "alert_by_id", "SELECT "+alertColumns+" FROM Alert WHERE id = ?", id))
+}
+
+// alertLifecycle persists engine events as Alert rows and announces every
+// state change on the live feed.
+func alertLifecycle(event alertEvent) {
+	switch event.Kind {
+	case alertTriggered:
+		openAlert(event)
+	case alertCleared:
+		autoResolveAlert(event)
+	}
+}
+
+func openAlert(event alertEvent) {
+	var exists bool
+	err := queryRowDB(context.Background(), "alert_open_exists", `SELECT EXISTS(SELECT 1 FROM Alert WHERE ruleId = ? AND deviceId = ? AND status IN (?, ?))`,
+		event.RuleID, event.DeviceID, alertStatusTriggered, alertStatusAcknowledged).Scan(&exists)
+	if err != nil {
+		log.Printf("Gagal memeriksa alert aktif: %v", err)
+		return
+	}
+	if exists {
+		return
+	}
+
+	message := fmt.Sprintf("%s: %s/%s = %v (%s %v)", event.RuleName, event.SiteAlias, event.Parameter, event.Value, event.Operator, event.Threshold)
+	at := event.Time.In(indonesiaLocation).Format(dbTimeLayout)
+	res, err := execDB(context.Background(), "alert_insert", `
+        INSERT INTO Alert (ruleId, ruleName, siteAlias, parameter, deviceId, severity, status, message, value, threshold, triggeredAt, updated)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		event.RuleID, event.RuleName, event.SiteAlias, event.Parameter, event.DeviceID, event.Severity,
+		alertStatusTriggered, message, event.Value, event.Threshold, at, at)
+	if err != nil {
+		log.Printf("Gagal menyimpan alert: %v", err)
+		return
+	}
+
+	id, _ := res.LastInsertId()
+	publishAlert(alertStatusTriggered, id)
+}
+
+func autoResolveAlert(event alertEvent) {
+	open, err := queryAlerts("SELECT "+alertColumns+" FROM Alert WHERE ruleId = ? AND deviceId = ? AND status IN (?, ?)",
+		event.RuleID, event.DeviceID, alertStatusTriggered, alertStatusAcknowledged)
+	if err != nil {
+		log.Printf("Gagal mencari alert aktif: %v", err)
+		return
+	}
+
+	for _, a := range open {
+		closeAlert(a, event.Time)
+	}
+}
+
+// closeAlert marks an open alert auto-resolved at the given time.
+func closeAlert(a alert, resolvedAt time.Time) {
+	at := resolvedAt.In(indonesiaLocation).Format(dbTimeLayout)
+	_, err := execDB(context.Background(), "alert_resolve", "UPDATE Alert SET status = ?, resolvedAt = ?, updated = ? WHERE id = ?",
+		alertStatusAutoResolved, at, at, a.ID)
+	if err != nil {
+		log.Printf("Gagal menutup alert %d: %v", a.ID, err)
+		return
+	}
+	publishAlert(alertStatusAutoResolved, a.ID)
+}
+
+// runAlertEscalation escalates unacknowledged alerts once per interval.
+func runAlertEscalation(ctx context.Context, interval time.Duration) {
+	for sleepContext(ctx, interval) {
+		now := time.Now().In(indonesiaLocation)
+		cutoff := now.Add(-escalateAfter).Format(dbTimeLayout)
+		due, err := queryAlerts(`SELECT `+alertColumns+` FROM Alert
+            WHERE status = ? AND escalationLevel < ? AND COALESCE(escalatedAt, triggeredAt) <= ?`,
+			alertStatusTriggered, maxEscalationLevel, cutoff)
+		if err != nil {
+			log.Printf("Gagal memeriksa eskalasi alert: %v", err)
+			continue
+		}
+
+		for _, a := range due {
+			_, err := execDB(context.Background(), "alert_escalate", "UPDATE Alert SET escalationLevel = escalationLevel + 1, escalatedAt = ?, updated = ? WHERE id = ? AND status = ?",
+				now.Format(dbTimeLayout), now.Format(dbTimeLayout), a.ID, alertStatusTriggered)
+			if err != nil {
+				log.Printf("Gagal eskalasi alert %d: %v", a.ID, err)
+				continue
+			}
+			log.Printf("Alert %d dieskalasi ke level %d", a.ID, a.EscalationLevel+1)
+			publishAlert("escalated", a.ID)
+		}
+	}
+}
+
+/* KODE PROGRAM - API ALERT */
+
+func getActiveAlerts(w http.ResponseWriter, r *http.Request) {
+	query := "SELECT " + alertColumns + " FROM Alert WHERE status IN (?, ?)"
+	args := []interface{}{alertStatusTriggered, alertStatusAcknowledged}
+	scope, scopeArgs := siteCondition(r, platform.PermRead, "siteAlias")
+	query += " AND " + scope
+	args = append(args, scopeArgs...)
+	if site := r.URL.Query().Get("site"); site != "" {
+		query += " AND siteAlias = ?"
+		args = append(args, site)
+	}
+
+	alerts, err := queryAlerts(query+" ORDER BY triggeredAt DESC", args...)
+	if err != nil {
+		log.Printf("Error querying active alerts: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil alert aktif")
+		return
+	}
+	writeJSON(w, http.StatusOK, alerts)
+}
+
+// searchAlerts filters the alert history by site, parameter, status,
+// severity, rule, time range and free text in the message or note.
+func searchAlerts(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, to, err := parseTimeRange(r, 30*24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scope, scopeArgs := siteCondition(r, platform.PermRead, "siteAlias")
+	query := "SELECT " + alertColumns + " FROM Alert WHERE triggeredAt >= ? AND triggeredAt < ? AND " + scope
+	args := append([]interface{}{from.Format(dbTimeLayout), to.Format(dbTimeLayout)}, scopeArgs...)
+	for _, filter := range []struct{ param, column string }{
+		{"site", "siteAlias"}, {"parameter", "parameter"}, {"status", "status"}, {"severity", "severity"}, {"ruleId", "ruleId"},
+	} {
+		if value := q.Get(filter.param); value != "" {
+			query += " AND " + filter.column + " = ?"
+			args = append(args, value)
+		}
+	}
+	if text := q.Get("q"); text != "" {
+		query += " AND (message LIKE ? OR note LIKE ?)"
+		args = append(args, "%"+text+"%", "%"+text+"%")
+	}
+
+	limit := 100
+	if s := q.Get("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil || limit <= 0 || limit > 1000 {
+			writeError(w, http.StatusBadRequest, "limit harus 1-1000")
+			return
+		}
+	}
+	query += " ORDER BY triggeredAt DESC LIMIT " + strconv.Itoa(limit)
+
+	alerts, err := queryAlerts(query, args...)
+	if err != nil {
+		log.Printf("Error searching alerts: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil riwayat alert")
+		return
+	}
+	writeJSON(w, http.StatusOK, alerts)
+}
+
+// alertActionRequest carries an optional note. By is only used when the
+// request has no authenticated user.
+type alertActionRequest struct {
+	By   string `json:"by"`
+	Note string `json:"note"`
+}
+
+// changeAlertStatus moves an alert from one of the given states to status,
+// recording who did it. Any other current state is answered with 409.
+func changeAlertStatus(w http.ResponseWriter, r *http.Request, status string, from ...string) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "id tidak valid")
+		return
+	}
+
+	if !requireRecordSite(w, r, platform.PermAlert, "Alert", id) {
+		return
+	}
+
+	var req alertActionRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "body JSON tidak valid")
+			return
+		}
+	}
+	if id, ok := platform.IdentityFrom(r); ok {
+		req.By = id.Subject
+	}
+	if req.By == "" {
+		writeError(w, http.StatusBadRequest, "by wajib diisi")
+		return
+	}
+
+	now := time.Now().In(indonesiaLocation).Format(dbTimeLayout)
+	query := "UPDATE Alert SET status = ?, acknowledgedAt = ?, acknowledgedBy = ?, note = COALESCE(?, note), updated = ?"
+	if status == alertStatusResolved {
+		query = "UPDATE Alert SET status = ?, resolvedAt = ?, resolvedBy = ?, note = COALESCE(?, note), updated = ?"
+	}
+	query += " WHERE id = ? AND status IN (" + placeholders(len(from)) + ")"
+	args := []interface{}{status, now, req.By, nullableString(req.Note), now, id}
+	for _, s := range from {
+		args = append(args, s)
+	}
+
+	res, err := execDB(r.Context(), "alert_status_update", query, args...)
+	if err != nil {
+		log.Printf("Error updating alert %d: %v", id, err)
+		writeError(w, http.StatusInternalServerError, "Gagal memperbarui alert")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		if _, err := getAlertByID(id); err == sql.ErrNoRows {
+			writeError(w, http.StatusNotFound, "Alert tidak ditemukan")
+			return
+		}
+		writeError(w, http.StatusConflict, fmt.Sprintf("Alert tidak dapat diubah menjadi %s", status))
+		return
+	}
+	a, err := getAlertByID(id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil alert")
+		return
+	}
+	publishAlert(status, id)
+	writeJSON(w, http.StatusOK, a)
+}
+
+func acknowledgeAlert(w http.ResponseWriter, r *http.Request) {
+	changeAlertStatus(w, r, alertStatusAcknowledged, alertStatusTriggered)
+}
+
+func resolveAlert(w http.ResponseWriter, r *http.Request) {
+	changeAlertStatus(w, r, alertStatusResolved, alertStatusTriggered, alertStatusAcknowledged)
+}
+
+/* KODE PROGRAM - FEED ALERT (WEBSOCKET) */
+
+type alertMessage struct {
+	Type  string `json:"type"`
+	Alert alert  `json:"alert"`
+}
+
+// alertHub fans alert messages out to connected WebSocket clients. A client
+// whose buffer fills up is disconnected instead of blocking the others.
+type alertHub struct {
+	mu      sync.Mutex
+	clients map[*websocket.Conn]*alertClient
+}
+
+// alertClient keeps the grants loaded when the client connected; a client
+// only receives alerts of the sites it may read.
+type alertClient struct {
+	send   chan []byte
+	grants []platform.RoleGrant
+}
+
+var alertFeed = &alertHub{clients: map[*websocket.Conn]*alertClient{}}
+
+var wsUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		for _, allowed := range allowedOrigins {
+			if origin == allowed {
+				return true
+			}
+		}
+		return false
+	},
+}
+
+func (h *alertHub) broadcast(site string, message []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for conn, client := range h.clients {
+		if !platform.GrantsAllowSite(client.grants, platform.PermRead, site) {
+			continue
+		}
+		select {
+		case client.send <- message:
+		default:
+			close(client.send)
+			delete(h.clients, conn)
+		}
+	}
+}
+
+func (h *alertHub) remove(conn *websocket.Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if client, ok := h.clients[conn]; ok {
+		close(client.send)
+		delete(h.clients, conn)
+	}
+}
+
+func publishAlert(kind string, id int64) {
+	a, err := getAlertByID(id)
+	if err != nil {
+		log.Printf("Gagal mengambil alert %d untuk feed: %v", id, err)
+		return
+	}
+	message, err := json.Marshal(alertMessage{Type: kind, Alert: a})
+	if err != nil {
+		return
+	}
+	alertFeed.broadcast(a.SiteAlias, message)
+	notifiers.dispatch(kind, a)
+}
+
+func alertFeedHandler(w http.ResponseWriter, r *http.Request) {
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("Gagal upgrade WebSocket: %v", err)
+		return
+	}
+
+	grants := platform.GrantsFrom(r)
+	send := make(chan []byte, 32)
+	alertFeed.mu.Lock()
+	alertFeed.clients[conn] = &alertClient{send: send, grants: grants}
+	alertFeed.mu.Unlock()
+
+	go func() {
+		defer conn.Close()
+		for message := range send {
+			conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				alertFeed.remove(conn)
+				return
+			}
+		}
+	}()
+
+	// Pesan dari klien diabaikan; pembacaan hanya untuk mendeteksi koneksi putus
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			alertFeed.remove(conn)
+			return
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	"platform"
+)
+
+func TestAlertFeedOnlySendsReadableSites(t *testing.T) {
+	hub := &alertHub{clients: map[*websocket.Conn]*alertClient{}}
+	site1 := &alertClient{send: make(chan []byte, 1), grants: []platform.RoleGrant{{Role: "viewer", SiteAlias: "tn_1"}}}
+	everySite := &alertClient{send: make(chan []byte, 1), grants: []platform.RoleGrant{{Role: "operator"}}}
+	hub.clients[&websocket.Conn{}] = site1
+	hub.clients[&websocket.Conn{}] = everySite
+
+	hub.broadcast("tn_2", []byte("alert tn_2"))
+	if len(site1.send) != 0 {
+		t.Error("klien tn_1 seharusnya tidak menerima alert tn_2")
+	}
+	if len(everySite.send) != 1 {
+		t.Error("klien semua lokasi seharusnya menerima alert tn_2")
+	}
+	<-everySite.send
+
+	hub.broadcast("tn_1", []byte("alert tn_1"))
+	if len(site1.send) != 1 || len(everySite.send) != 1 {
+		t.Error("kedua klien seharusnya menerima alert tn_1")
+	}
+}
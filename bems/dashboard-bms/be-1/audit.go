@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"platform"
+)
+
+/* KODE PROGRAM - JEJAK AUDIT */
+
+// auditService identifies this backend in the shared AuditLog table.
+const auditService = "integrasi"
+
+// auditBodyLimit caps how much of a JSON body is kept as parameters.
+const auditBodyLimit = 64 << 10
+
+var auditMethods = map[string]bool{"POST": true, "PUT": true, "PATCH": true, "DELETE": true}
+
+// auditSecretKeys are parameter names whose values never reach the log.
+var auditSecretKeys = []string{"password", "secret", "token", "apikey", "api_key"}
+
+type auditEntryKey struct{}
+
+// auditEntry is filled while a request is served; handlers and auth
+// middleware further down name the actor through setAuditActor.
+type auditEntry struct {
+	Actor string
+}
+
+// setAuditActor records who is performing the current request.
+func setAuditActor(r *http.Request, actor string) {
+	if entry, ok := r.Context().Value(auditEntryKey{}).(*auditEntry); ok {
+		entry.Actor = actor
+	}
+}
+
+// trustedProxies are the reverse proxies whose X-Forwarded-For header is
+// believed (http.trustedProxies). Any other peer could forge the header, so
+// its own address is used instead.
+var trustedProxies []*net.IPNet
+
+// parseTrustedProxies reads IP addresses and CIDR ranges.
+func parseTrustedProxies(list []string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, entry := range list {
+		entry = strings.TrimSpace(entry)
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("proxy tidak valid: %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("proxy tidak valid: %q", entry)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+func isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP is the peer address, or when the peer is a trusted proxy the
+// right-most X-Forwarded-For hop that is not itself a trusted proxy.
+func clientIP(r *http.Request) string {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ip = host
+	}
+	if !isTrustedProxy(ip) {
+		return ip
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		ip = hop
+		if !isTrustedProxy(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+func redactParams(params map[string]interface{}) {
+	for key := range params {
+		lower := strings.ToLower(key)
+		for _, secret := range auditSecretKeys {
+			if strings.Contains(lower, secret) {
+				params[key] = "[redacted]"
+				break
+			}
+		}
+	}
+}
+
+// requestParams collects the query string, form fields (file parts by file
+// name only) and the top-level fields of a JSON body.
+func requestParams(r *http.Request, body []byte) map[string]interface{} {
+	params := map[string]interface{}{}
+	for key, values := range r.URL.Query() {
+		params[key] = strings.Join(values, ",")
+	}
+	if r.MultipartForm != nil {
+		for key, values := range r.MultipartForm.Value {
+			params[key] = strings.Join(values, ",")
+		}
+		for key, files := range r.MultipartForm.File {
+			names := make([]string, len(files))
+			for i, f := range files {
+				names[i] = f.Filename
+			}
+			params[key] = strings.Join(names, ",")
+		}
+	} else if r.PostForm != nil {
+		for key, values := range r.PostForm {
+			params[key] = strings.Join(values, ",")
+		}
+	}
+	if len(body) > 0 {
+		var fields map[string]interface{}
+		if json.Unmarshal(body, &fields) == nil {
+			for key, value := range fields {
+				params[key] = value
+			}
+		}
+	}
+	redactParams(params)
+	return params
+}
+
+// auditMiddleware appends one AuditLog row for every mutating request after
+// it has been served.
+func auditMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !auditMethods[r.Method] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var body []byte
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") && r.Body != nil {
+			body, _ = io.ReadAll(io.LimitReader(r.Body, auditBodyLimit))
+			r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+		}
+
+		entry := &auditEntry{Actor: "anonymous"}
+		r = r.WithContext(context.WithValue(r.Context(), auditEntryKey{}, entry))
+		recorder := &platform.StatusRecorder{ResponseWriter: w, Status: http.StatusOK}
+		started := time.Now()
+
+		next.ServeHTTP(recorder, r)
+
+		action := r.Method + " " + r.URL.Path
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				action = r.Method + " " + template
+			}
+		}
+		target, _ := json.Marshal(mux.Vars(r))
+		params, _ := json.Marshal(requestParams(r, body))
+		outcome := "success"
+		if recorder.Status >= 400 {
+			outcome = "failure"
+		}
+
+		_, err := execDB(context.WithoutCancel(r.Context()), "audit_insert", `
+            INSERT INTO AuditLog (created, service, actor, action, target, params, outcome, status, sourceIp, durationMs)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			started.In(indonesiaLocation).Format(dbTimeLayout), auditService, entry.Actor, action, string(target),
+			string(params), outcome, recorder.Status, clientIP(r), time.Since(started).Milliseconds())
+		if err != nil {
+			log.Printf("Gagal mencatat jejak audit %s: %v", action, err)
+		}
+	})
+}
+
+/* KODE PROGRAM - API JEJAK AUDIT */
+
+type auditRecord struct {
+	ID         int64           `json:"id"`
+	Created    time.Time       `json:"created"`
+	Service    string          `json:"service"`
+	Actor      string          `json:"actor"`
+	Action     string          `json:"action"`
+	Target     json.RawMessage `json:"target"`
+	Params     json.RawMessage `json:"params"`
+	Outcome    string          `json:"outcome"`
+	Status     int             `json:"status"`
+	SourceIP   string          `json:"sourceIp"`
+	DurationMs int64           `json:"durationMs"`
+}
+
+// getAuditLog lists audit entries of both backends. Filters: from, to,
+// service, actor, outcome, sourceIp, action (substring) and limit.
+func getAuditLog(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, to, err := parseTimeRange(r, 7*24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	limit := 500
+	if s := q.Get("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil || limit <= 0 || limit > 5000 {
+			writeError(w, http.StatusBadRequest, "limit harus antara 1 dan 5000")
+			return
+		}
+	}
+
+	query := `SELECT id, created, service, actor, action, target, params, outcome, status, sourceIp, durationMs
+        FROM AuditLog WHERE created >= ? AND created < ?`
+	args := []interface{}{from.Format(dbTimeLayout), to.Format(dbTimeLayout)}
+	for _, filter := range []struct{ param, column string }{
+		{"service", "service"}, {"actor", "actor"}, {"outcome", "outcome"}, {"sourceIp", "sourceIp"},
+	} {
+		if value := q.Get(filter.param); value != "" {
+			query += " AND " + filter.column + " = ?"
+			args = append(args, value)
+		}
+	}
+	if action := q.Get("action"); action != "" {
+		query += " AND action LIKE ?"
+		args = append(args, "%"+action+"%")
+	}
+	args = append(args, limit)
+
+	rows, err := queryDB(r.Context(), "audit_log", query+" ORDER BY created DESC, id DESC LIMIT ?", args...)
+	if err != nil {
+		log.Printf("Error querying audit log: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil jejak audit")
+		return
+	}
+	defer rows.Close()
+
+	records := []auditRecord{}
+	for rows.Next() {
+		var a auditRecord
+		var target, params sql.NullString
+		if err := rows.Scan(&a.ID, &a.Created, &a.Service, &a.Actor, &a.Action, &target, &params,
+			&a.Outcome, &a.Status, &a.SourceIP, &a.DurationMs); err != nil {
+			log.Printf("Error scanning audit log: %v", err)
+			writeError(w, http.StatusInternalServerError, "Gagal membaca jejak audit")
+			return
+		}
+		a.Created = localTime(a.Created)
+		a.Target = json.RawMessage(nonEmptyJSON(target.String))
+		a.Params = json.RawMessage(nonEmptyJSON(params.String))
+		records = append(records, a)
+	}
+	writeJSON(w, http.StatusOK, records)
+}
+
+func nonEmptyJSON(s string) string {
+	if s == "" {
+		return "null"
+	}
+	return s
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"platform"
+)
+
+/* KODE PROGRAM - AUTENTIKASI JWT */
+
+// jwtSecret is the HS256 key shared with be-3 (SECRET_KEY). Without it every
+// protected route answers 401.
+var jwtSecret []byte
+
+// publicRoutes are "METHOD /path/template" entries served without a token.
+// Only read-only routes belong here; see AUTH_PUBLIC_ROUTES.
+var publicRoutes = map[string]bool{
+	"GET /api/monitoring/{roomId}":                     true,
+	"GET /api/grafik/{siteAlias}/{aliasDeviceID}":      true,
+	"GET /api/grafik-prediksi/{siteAlias}/{parameter}": true,
+	"GET /api/iaq":                          true,
+	"GET /api/iaq/{roomId}":                 true,
+	"GET /api/iaq/{roomId}/history":         true,
+	"GET /api/lighting":                     true,
+	"GET /api/lighting/{siteAlias}":         true,
+	"GET /api/lighting/{siteAlias}/daily":   true,
+	"GET /api/soft-sensor/accuracy":         true,
+	"GET /api/soft-sensor/accuracy/history": true,
+	"GET /api/demand":                       true,
+}
+
+// authMiddleware lets public routes through and requires a valid token on
+// every other route. A valid token on a public route still sets the identity.
+func authMiddleware(next http.Handler) http.Handler {
+	auth := platform.Auth{
+		Secret:       jwtSecret,
+		PublicRoutes: publicRoutes,
+		Accept: func(r *http.Request, id *platform.Identity) {
+			setAuditActor(r, id.Subject)
+		},
+		Reject: rejectToken,
+	}
+	return auth.Middleware(next)
+}
+
+// rejectToken answers 401, logging tokens that were presented but refused.
+func rejectToken(w http.ResponseWriter, r *http.Request, err error) {
+	if err != platform.ErrTokenMissing {
+		log.Printf("Token ditolak untuk %s dari %s: %v", platform.RouteKey(r), clientIP(r), err)
+	}
+	writeError(w, http.StatusUnauthorized, err.Error())
+}
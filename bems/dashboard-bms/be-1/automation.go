@@ -0,0 +1,604 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"platform"
+)
+
+/* KODE PROGRAM - OTOMASI BERBASIS KONDISI */
+
+// automationCondition compares the latest reading of a parameter on the
+// rule's site. Source selects the table: "value" (Value), "stat" (Stat, 1 =
+// on) or "predict" (Predict, soft-sensor of the given physical parameter).
+// With For set, the condition must have held continuously for that many
+// seconds.
+type automationCondition struct {
+	Source    string  `json:"source"`
+	Parameter string  `json:"parameter"`
+	Operator  string  `json:"operator"`
+	Threshold float64 `json:"threshold"`
+	For       int     `json:"for"`
+}
+
+type automationAction struct {
+	DeviceAlias string   `json:"deviceAlias"`
+	State       string   `json:"state"`
+	Setpoint    *float64 `json:"setpoint"`
+}
+
+// automationRule fires its action when every condition holds inside the
+// optional daily window on the listed weekdays (0 = Sunday, empty = every
+// day). DryRun rules only write to the execution log.
+// Cooldown (seconds) is the minimum time between two firings.
+type automationRule struct {
+	ID         int64                 `json:"id"`
+	Name       string                `json:"name"`
+	SiteAlias  string                `json:"siteAlias"`
+	Conditions []automationCondition `json:"conditions"`
+	Action     automationAction      `json:"action"`
+	ActiveFrom string                `json:"activeFrom"`
+	ActiveTo   string                `json:"activeTo"`
+	Days       []int                 `json:"days"`
+	Cooldown   int                   `json:"cooldown"`
+	DryRun     bool                  `json:"dryRun"`
+	Enabled    bool                  `json:"enabled"`
+}
+
+var automationSources = map[string]bool{"value": true, "stat": true, "predict": true}
+
+func compareValue(operator string, value, threshold float64) bool {
+	switch operator {
+	case ">":
+		return value > threshold
+	case ">=":
+		return value >= threshold
+	case "<":
+		return value < threshold
+	case "<=":
+		return value <= threshold
+	case "==":
+		return value == threshold
+	case "!=":
+		return value != threshold
+	}
+	return false
+}
+
+func (a *automationRule) validate() error {
+	if a.Name == "" || a.SiteAlias == "" {
+		return fmt.Errorf("name dan siteAlias wajib diisi")
+	}
+	if len(a.Conditions) == 0 {
+		return fmt.Errorf("minimal satu kondisi wajib diisi")
+	}
+	for i, c := range a.Conditions {
+		if !automationSources[c.Source] {
+			return fmt.Errorf("kondisi %d: source harus value, stat atau predict", i+1)
+		}
+		if c.Parameter == "" {
+			return fmt.Errorf("kondisi %d: parameter wajib diisi", i+1)
+		}
+		if !ruleOperators[c.Operator] && c.Operator != "==" && c.Operator != "!=" {
+			return fmt.Errorf("kondisi %d: operator tidak valid: %s", i+1, c.Operator)
+		}
+		if c.For < 0 {
+			return fmt.Errorf("kondisi %d: for tidak boleh negatif", i+1)
+		}
+	}
+	if err := validateSwitchTarget(a.SiteAlias, a.Action.DeviceAlias, a.Action.State, a.Action.Setpoint); err != nil {
+		return err
+	}
+	for _, day := range a.Days {
+		if day < 0 || day > 6 {
+			return fmt.Errorf("hari tidak valid: %d", day)
+		}
+	}
+	if a.Cooldown < 0 {
+		return fmt.Errorf("cooldown tidak boleh negatif")
+	}
+	if a.Cooldown == 0 {
+		a.Cooldown = 900
+	}
+	if (a.ActiveFrom == "") != (a.ActiveTo == "") {
+		return fmt.Errorf("activeFrom dan activeTo harus diisi bersamaan")
+	}
+	if a.ActiveFrom != "" {
+		if _, err := parseClock(a.ActiveFrom); err != nil {
+			return err
+		}
+		if _, err := parseClock(a.ActiveTo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// conditionSeries returns the repository and Parameter alias that hold a
+// condition's readings.
+func conditionSeries(c automationCondition) (seriesRepository, string) {
+	switch c.Source {
+	case "stat":
+		return repo.Stats, c.Parameter
+	case "predict":
+		return repo.Predictions, softSensorPrefix + c.Parameter
+	}
+	return repo.Values, c.Parameter
+}
+
+// conditionHolds checks the reading in effect at now, and with For set also
+// the reading in effect at the start of the window and every reading since.
+// A value or prediction older than staleAfter never holds, so a dead sensor
+// cannot keep a rule firing; Stat rows are only written on a change and are
+// exempt.
+func conditionHolds(siteAlias string, c automationCondition, now time.Time) (bool, float64, error) {
+	ctx := context.Background()
+	readings, alias := conditionSeries(c)
+	windowStart := now.Add(-time.Duration(c.For) * time.Second)
+
+	p, err := repo.Parameters.Find(ctx, siteAlias, alias)
+	if err == sql.ErrNoRows {
+		return false, 0, nil
+	}
+	if err != nil {
+		return false, 0, err
+	}
+
+	// Nilai terakhir sebelum/tepat di awal jendela
+	point, err := readings.Before(ctx, p.ID, windowStart)
+	if err == sql.ErrNoRows {
+		return false, 0, nil
+	}
+	if err != nil {
+		return false, 0, err
+	}
+	fresh := func(t time.Time) bool {
+		return c.Source == "stat" || now.Sub(t) <= staleAfter
+	}
+	latest := point.Value
+	if !compareValue(c.Operator, latest, c.Threshold) {
+		return false, latest, nil
+	}
+	if c.For == 0 {
+		return fresh(point.Time), latest, nil
+	}
+
+	window, err := readings.Range(ctx, p.ID, windowStart, now)
+	if err != nil {
+		return false, 0, err
+	}
+	latestAt := point.Time
+	for _, point := range window {
+		latest, latestAt = point.Value, point.Time
+		if !compareValue(c.Operator, latest, c.Threshold) {
+			return false, latest, nil
+		}
+	}
+	return fresh(latestAt), latest, nil
+}
+
+// currentSwitchState returns "on"/"off" from the latest Stat row of a device,
+// or "" when it has never reported.
+func currentSwitchState(siteAlias, deviceAlias string) (string, error) {
+	ctx := context.Background()
+	p, err := repo.Parameters.Find(ctx, siteAlias, deviceAlias)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	stats, err := repo.Stats.Recent(ctx, p.ID, 1)
+	if err != nil || len(stats) == 0 {
+		return "", err
+	}
+	if stats[0].Value == 1 {
+		return "on", nil
+	}
+	return "off", nil
+}
+
+type conditionResult struct {
+	automationCondition
+	Value float64 `json:"value"`
+	Holds bool    `json:"holds"`
+}
+
+type automationEvaluation struct {
+	RuleID       int64             `json:"ruleId"`
+	InWindow     bool              `json:"inWindow"`
+	Conditions   []conditionResult `json:"conditions"`
+	Triggered    bool              `json:"triggered"`
+	CurrentState string            `json:"currentState"`
+	WouldAct     bool              `json:"wouldAct"`
+}
+
+func (a automationRule) activeAt(t time.Time) bool {
+	if len(a.Days) > 0 {
+		today := false
+		for _, day := range a.Days {
+			today = today || time.Weekday(day) == t.Weekday()
+		}
+		if !today {
+			return false
+		}
+	}
+	return alertRule{ActiveFrom: a.ActiveFrom, ActiveTo: a.ActiveTo}.inWindow(t)
+}
+
+func (a automationRule) evaluate(now time.Time) (automationEvaluation, error) {
+	eval := automationEvaluation{RuleID: a.ID, InWindow: a.activeAt(now)}
+
+	eval.Triggered = eval.InWindow
+	for _, c := range a.Conditions {
+		holds, value, err := conditionHolds(a.SiteAlias, c, now)
+		if err != nil {
+			return eval, err
+		}
+		eval.Conditions = append(eval.Conditions, conditionResult{automationCondition: c, Value: value, Holds: holds})
+		eval.Triggered = eval.Triggered && holds
+	}
+
+	state, err := currentSwitchState(a.SiteAlias, a.Action.DeviceAlias)
+	if err != nil {
+		return eval, err
+	}
+	eval.CurrentState = state
+	// Aksi on/off yang sudah sesuai status perangkat tidak dijalankan ulang
+	eval.WouldAct = eval.Triggered && (a.Action.State == "" || a.Action.State != state)
+	return eval, nil
+}
+
+/* KODE PROGRAM - EKSEKUSI OTOMASI */
+
+var (
+	automationMu        sync.Mutex
+	automationLastFired = map[int64]time.Time{}
+)
+
+func logAutomation(a automationRule, outcome, correlationID, message string, eval automationEvaluation) {
+	detail, _ := json.Marshal(eval.Conditions)
+	_, err := execDB(context.Background(), "automation_log_insert", `
+        INSERT INTO AutomationLog (ruleId, ruleName, siteAlias, deviceAlias, state, setpoint, dryRun, outcome, correlationId, message, conditions, created)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		a.ID, a.Name, a.SiteAlias, a.Action.DeviceAlias, nullableString(a.Action.State), floatArg(a.Action.Setpoint),
+		a.DryRun, outcome, nullableString(correlationID), nullableString(message), string(detail),
+		time.Now().In(indonesiaLocation).Format(dbTimeLayout))
+	if err != nil {
+		log.Printf("Gagal mencatat log otomasi %d: %v", a.ID, err)
+	}
+}
+
+// runAutomations evaluates every enabled rule once per interval.
+func runAutomations(ctx context.Context, interval time.Duration) {
+	for sleepContext(ctx, interval) {
+		rules, err := listAutomationRules("WHERE enabled = 1 ORDER BY id")
+		if err != nil {
+			log.Printf("Gagal memuat aturan otomasi: %v", err)
+			continue
+		}
+		now := time.Now().In(indonesiaLocation)
+		for _, a := range rules {
+			runAutomation(a, now)
+		}
+	}
+}
+
+func runAutomation(a automationRule, now time.Time) {
+	automationMu.Lock()
+	last := automationLastFired[a.ID]
+	automationMu.Unlock()
+	if now.Sub(last) < time.Duration(a.Cooldown)*time.Second {
+		return
+	}
+
+	eval, err := a.evaluate(now)
+	if err != nil {
+		log.Printf("Gagal mengevaluasi otomasi %d: %v", a.ID, err)
+		return
+	}
+	if !eval.WouldAct {
+		return
+	}
+
+	automationMu.Lock()
+	automationLastFired[a.ID] = now
+	automationMu.Unlock()
+
+	if a.DryRun {
+		log.Printf("Otomasi %d (dry-run): %s/%s -> %s", a.ID, a.SiteAlias, a.Action.DeviceAlias, a.Action.State)
+		logAutomation(a, "dry_run", "", "", eval)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), controlAckTimeout+5*time.Second)
+	defer cancel()
+	result, err := sendControlCommand(ctx, a.SiteAlias, a.Action.DeviceAlias, controlCommand{
+		State:    a.Action.State,
+		Setpoint: a.Action.Setpoint,
+		Source:   fmt.Sprintf("automation:%d", a.ID),
+	})
+	if err != nil {
+		log.Printf("Otomasi %d gagal: %v", a.ID, err)
+		logAutomation(a, "failed", "", err.Error(), eval)
+		return
+	}
+	log.Printf("Otomasi %d: %s/%s -> %s", a.ID, a.SiteAlias, a.Action.DeviceAlias, result.State)
+	logAutomation(a, "executed", result.CorrelationID, "", eval)
+}
+
+/* KODE PROGRAM - API OTOMASI */
+
+const automationColumns = "id, name, siteAlias, conditions, deviceAlias, state, setpoint, activeFrom, activeTo, days, cooldown, dryRun, enabled"
+
+func scanAutomationRule(row rowScanner) (automationRule, error) {
+	var a automationRule
+	var conditions string
+	var state, activeFrom, activeTo, days sql.NullString
+	var setpoint sql.NullFloat64
+	err := row.Scan(&a.ID, &a.Name, &a.SiteAlias, &conditions, &a.Action.DeviceAlias, &state, &setpoint,
+		&activeFrom, &activeTo, &days, &a.Cooldown, &a.DryRun, &a.Enabled)
+	if err != nil {
+		return a, err
+	}
+	a.Action.State = state.String
+	a.Action.Setpoint = nullableFloat(setpoint)
+	a.ActiveFrom = activeFrom.String
+	a.ActiveTo = activeTo.String
+	a.Days = []int{}
+	if days.String != "" {
+		a.Days = splitDays(days.String)
+	}
+	return a, json.Unmarshal([]byte(conditions), &a.Conditions)
+}
+
+func listAutomationRules(query string, args ...interface{}) ([]automationRule, error) {
+	rows, err := queryDB(context.Background(), "automation_rules", "SELECT "+automationColumns+" FROM AutomationRule "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []automationRule{}
+	for rows.Next() {
+		a, err := scanAutomationRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, a)
+	}
+	return rules, rows.Err()
+}
+
+func getAutomationByID(id string) (automationRule, error) {
+	return scanAutomationRule(queryRowDB(context.Background(), "automation_rule_by_id", "SELECT "+automationColumns+" FROM AutomationRule WHERE id = ?", id))
+}
+
+func decodeAutomationRule(r *http.Request) (automationRule, error) {
+	a := automationRule{Enabled: true, DryRun: true}
+	if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+		return a, fmt.Errorf("body JSON tidak valid: %v", err)
+	}
+	return a, a.validate()
+}
+
+func getAutomationRules(w http.ResponseWriter, r *http.Request) {
+	scope, args := siteCondition(r, platform.PermRead, "siteAlias")
+	rules, err := listAutomationRules("WHERE "+scope+" ORDER BY id", args...)
+	if err != nil {
+		log.Printf("Error querying automation rules: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil aturan otomasi")
+		return
+	}
+	writeJSON(w, http.StatusOK, rules)
+}
+
+func getAutomationRule(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !requireRecordSite(w, r, platform.PermRead, "AutomationRule", id) {
+		return
+	}
+	a, err := getAutomationByID(id)
+	if err == sql.ErrNoRows {
+		writeError(w, http.StatusNotFound, "Aturan otomasi tidak ditemukan")
+		return
+	}
+	if err != nil {
+		log.Printf("Error querying automation rule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil aturan otomasi")
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+func createAutomationRule(w http.ResponseWriter, r *http.Request) {
+	a, err := decodeAutomationRule(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !requireSite(w, r, platform.PermControl, a.SiteAlias) {
+		return
+	}
+
+	conditions, _ := json.Marshal(a.Conditions)
+	now := time.Now().In(indonesiaLocation).Format(dbTimeLayout)
+	res, err := execDB(r.Context(), "automation_rule_insert", `
+        INSERT INTO AutomationRule (name, siteAlias, conditions, deviceAlias, state, setpoint, activeFrom, activeTo, days, cooldown, dryRun, enabled, created, updated)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		a.Name, a.SiteAlias, string(conditions), a.Action.DeviceAlias, nullableString(a.Action.State), floatArg(a.Action.Setpoint),
+		nullableString(a.ActiveFrom), nullableString(a.ActiveTo), nullableString(joinDays(a.Days)), a.Cooldown, a.DryRun, a.Enabled, now, now)
+	if err != nil {
+		log.Printf("Error inserting automation rule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menyimpan aturan otomasi")
+		return
+	}
+	a.ID, _ = res.LastInsertId()
+	writeJSON(w, http.StatusCreated, a)
+}
+
+func updateAutomationRule(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "id tidak valid")
+		return
+	}
+	a, err := decodeAutomationRule(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	a.ID = id
+	if !requireSite(w, r, platform.PermControl, a.SiteAlias) || !requireRecordSite(w, r, platform.PermControl, "AutomationRule", id) {
+		return
+	}
+
+	conditions, _ := json.Marshal(a.Conditions)
+	res, err := execDB(r.Context(), "automation_rule_update", `
+        UPDATE AutomationRule
+        SET name = ?, siteAlias = ?, conditions = ?, deviceAlias = ?, state = ?, setpoint = ?, activeFrom = ?, activeTo = ?,
+            days = ?, cooldown = ?, dryRun = ?, enabled = ?, updated = ?
+        WHERE id = ?`,
+		a.Name, a.SiteAlias, string(conditions), a.Action.DeviceAlias, nullableString(a.Action.State), floatArg(a.Action.Setpoint),
+		nullableString(a.ActiveFrom), nullableString(a.ActiveTo), nullableString(joinDays(a.Days)), a.Cooldown, a.DryRun, a.Enabled,
+		time.Now().In(indonesiaLocation).Format(dbTimeLayout), id)
+	if err != nil {
+		log.Printf("Error updating automation rule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal memperbarui aturan otomasi")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		var exists bool
+		if err := queryRowDB(r.Context(), "automation_rule_exists", "SELECT EXISTS(SELECT 1 FROM AutomationRule WHERE id = ?)", id).Scan(&exists); err != nil {
+			log.Printf("Error checking automation rule: %v", err)
+			writeError(w, http.StatusInternalServerError, "Gagal memperbarui aturan otomasi")
+			return
+		}
+		if !exists {
+			writeError(w, http.StatusNotFound, "Aturan otomasi tidak ditemukan")
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+func deleteAutomationRule(w http.ResponseWriter, r *http.Request) {
+	if !requireRecordSite(w, r, platform.PermControl, "AutomationRule", mux.Vars(r)["id"]) {
+		return
+	}
+	res, err := execDB(r.Context(), "automation_rule_delete", "DELETE FROM AutomationRule WHERE id = ?", mux.Vars(r)["id"])
+	if err != nil {
+		log.Printf("Error deleting automation rule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menghapus aturan otomasi")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		writeError(w, http.StatusNotFound, "Aturan otomasi tidak ditemukan")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"message": "Sukses"})
+}
+
+// getAutomationEvaluation reports what a rule would do right now without
+// acting or logging, regardless of its dry-run flag.
+func getAutomationEvaluation(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !requireRecordSite(w, r, platform.PermRead, "AutomationRule", id) {
+		return
+	}
+	a, err := getAutomationByID(id)
+	if err == sql.ErrNoRows {
+		writeError(w, http.StatusNotFound, "Aturan otomasi tidak ditemukan")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil aturan otomasi")
+		return
+	}
+
+	eval, err := a.evaluate(time.Now().In(indonesiaLocation))
+	if err != nil {
+		log.Printf("Error evaluating automation rule %d: %v", a.ID, err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengevaluasi aturan otomasi")
+		return
+	}
+	writeJSON(w, http.StatusOK, eval)
+}
+
+type automationLogEntry struct {
+	ID            int64           `json:"id"`
+	RuleID        int64           `json:"ruleId"`
+	RuleName      string          `json:"ruleName"`
+	SiteAlias     string          `json:"siteAlias"`
+	DeviceAlias   string          `json:"deviceAlias"`
+	State         string          `json:"state"`
+	Setpoint      *float64        `json:"setpoint"`
+	DryRun        bool            `json:"dryRun"`
+	Outcome       string          `json:"outcome"`
+	CorrelationID string          `json:"correlationId"`
+	Message       string          `json:"message"`
+	Conditions    json.RawMessage `json:"conditions"`
+	Created       time.Time       `json:"created"`
+}
+
+func getAutomationLog(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, to, err := parseTimeRange(r, 7*24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scope, scopeArgs := siteCondition(r, platform.PermRead, "siteAlias")
+	query := `SELECT id, ruleId, ruleName, siteAlias, deviceAlias, state, setpoint, dryRun, outcome, correlationId, message, conditions, created
+        FROM AutomationLog WHERE created >= ? AND created < ? AND ` + scope
+	args := append([]interface{}{from.Format(dbTimeLayout), to.Format(dbTimeLayout)}, scopeArgs...)
+	for _, filter := range []struct{ param, column string }{
+		{"rule", "ruleId"}, {"site", "siteAlias"}, {"outcome", "outcome"},
+	} {
+		if value := q.Get(filter.param); value != "" {
+			query += " AND " + filter.column + " = ?"
+			args = append(args, value)
+		}
+	}
+
+	rows, err := queryDB(r.Context(), "automation_log", query+" ORDER BY created DESC LIMIT 1000", args...)
+	if err != nil {
+		log.Printf("Error querying automation log: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil log otomasi")
+		return
+	}
+	defer rows.Close()
+
+	entries := []automationLogEntry{}
+	for rows.Next() {
+		var e automationLogEntry
+		var state, correlationID, message sql.NullString
+		var setpoint sql.NullFloat64
+		var conditions string
+		if err := rows.Scan(&e.ID, &e.RuleID, &e.RuleName, &e.SiteAlias, &e.DeviceAlias, &state, &setpoint, &e.DryRun,
+			&e.Outcome, &correlationID, &message, &conditions, &e.Created); err != nil {
+			log.Printf("Error scanning automation log: %v", err)
+			writeError(w, http.StatusInternalServerError, "Gagal membaca log otomasi")
+			return
+		}
+		e.State = state.String
+		e.Setpoint = nullableFloat(setpoint)
+		e.CorrelationID = correlationID.String
+		e.Message = message.String
+		e.Conditions = json.RawMessage(conditions)
+		e.Created = localTime(e.Created)
+		entries = append(entries, e)
+	}
+	writeJSON(w, http.StatusOK, entries)
+}
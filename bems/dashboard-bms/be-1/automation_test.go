@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestConditionHoldsRejectsStaleReadings(t *testing.T) {
+	testRepositories(t)
+	now := time.Now().In(indonesiaLocation).Truncate(time.Second)
+	c := automationCondition{Source: "value", Parameter: "co2", Operator: ">", Threshold: 800}
+
+	if err := repo.Values.Insert(context.Background(), "device-co2", 900, now.Add(-2*staleAfter)); err != nil {
+		t.Fatal(err)
+	}
+	holds, value, err := conditionHolds("tn_1", c, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if holds || value != 900 {
+		t.Fatalf("bacaan basi: holds = %v, value = %v; seharusnya tidak terpenuhi", holds, value)
+	}
+
+	if err := repo.Values.Insert(context.Background(), "device-co2", 950, now.Add(-time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if holds, _, err = conditionHolds("tn_1", c, now); err != nil || !holds {
+		t.Fatalf("bacaan baru: holds = %v, err = %v; seharusnya terpenuhi", holds, err)
+	}
+
+	c.For = 120
+	if holds, _, err = conditionHolds("tn_1", c, now); err != nil || !holds {
+		t.Fatalf("dengan For: holds = %v, err = %v; seharusnya terpenuhi", holds, err)
+	}
+}
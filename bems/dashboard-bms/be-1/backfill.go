@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/* KODE PROGRAM - BACKFILL NILAI NUMERIK */
+
+// backfillOptions controls a -backfill-values run.
+type backfillOptions struct {
+	BatchSize int
+	Pause     time.Duration
+	DryRun    bool
+}
+
+// backfillReport summarises a run.
+type backfillReport struct {
+	Converted   int
+	Unparseable int
+}
+
+type pendingValue struct {
+	ID       int64
+	DeviceID string
+	Created  string
+	Value    string
+}
+
+// backfillValues fills Value.numericValue for rows written before migration
+// 0002, walking the table by id in batches so ingest keeps running. Each
+// batch is one transaction and the run can be stopped and restarted at any
+// time. Rows that do not parse as a finite number are logged and moved to
+// ValueText; with DryRun nothing is written.
+func backfillValues(ctx context.Context, conn *sql.DB, opts backfillOptions) (backfillReport, error) {
+	var report backfillReport
+	var lastId int64
+	for {
+		batch, err := pendingValues(ctx, conn, lastId, opts.BatchSize)
+		if err != nil {
+			return report, err
+		}
+		if len(batch) == 0 {
+			return report, nil
+		}
+		lastId = batch[len(batch)-1].ID
+
+		converted, unparseable, err := backfillBatch(ctx, conn, batch, opts.DryRun)
+		if err != nil {
+			return report, err
+		}
+		report.Converted += converted
+		report.Unparseable += unparseable
+		log.Printf("Backfill sampai id %d: %d dikonversi, %d bukan angka", lastId, report.Converted, report.Unparseable)
+
+		select {
+		case <-ctx.Done():
+			return report, ctx.Err()
+		case <-time.After(opts.Pause):
+		}
+	}
+}
+
+func pendingValues(ctx context.Context, conn *sql.DB, afterId int64, limit int) ([]pendingValue, error) {
+	rows, err := conn.QueryContext(ctx, `
+        SELECT id, deviceId, created, value FROM Value
+        WHERE id > ? AND numericValue IS NULL
+        ORDER BY id LIMIT ?`, afterId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batch []pendingValue
+	for rows.Next() {
+		var v pendingValue
+		var created time.Time
+		if err := rows.Scan(&v.ID, &v.DeviceID, &created, &v.Value); err != nil {
+			return nil, err
+		}
+		v.Created = created.Format(dbTimeLayout)
+		batch = append(batch, v)
+	}
+	return batch, rows.Err()
+}
+
+func backfillBatch(ctx context.Context, conn *sql.DB, batch []pendingValue, dryRun bool) (converted, unparseable int, err error) {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	for _, v := range batch {
+		number, err := strconv.ParseFloat(strings.TrimSpace(v.Value), 64)
+		if err == nil && !math.IsNaN(number) && !math.IsInf(number, 0) {
+			converted++
+			if dryRun {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, "UPDATE Value SET numericValue = ? WHERE id = ?", number, v.ID); err != nil {
+				return 0, 0, err
+			}
+			continue
+		}
+
+		unparseable++
+		log.Printf("Value id %d (perangkat %s, %s) bukan angka: %q", v.ID, v.DeviceID, v.Created, v.Value)
+		if dryRun {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO ValueText (valueId, created, value, deviceId) VALUES (?, ?, ?, ?)",
+			v.ID, v.Created, v.Value, v.DeviceID); err != nil {
+			return 0, 0, err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM Value WHERE id = ?", v.ID); err != nil {
+			return 0, 0, err
+		}
+	}
+	return converted, unparseable, tx.Commit()
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+/* KODE PROGRAM - BACKFILL TIMESCALEDB */
+
+// backfillTimescale copies the Value history of the main database into the
+// TimescaleDB hypertable. Readings ingested since timeseries.driver was set
+// to timescale are already in both, so only rows older than the oldest
+// hypertable row are copied, newest first. Each batch is one transaction and
+// never splits a timestamp, so the run can be stopped and restarted at any
+// time. Rows without numericValue are skipped; run -backfill-values first.
+// With DryRun nothing is written.
+func backfillTimescale(ctx context.Context, conn, ts *sql.DB, opts backfillOptions) (int, error) {
+	var oldest sql.NullTime
+	if err := ts.QueryRowContext(ctx, "SELECT min(created) FROM value").Scan(&oldest); err != nil {
+		return 0, err
+	}
+	before := time.Now().In(indonesiaLocation)
+	if oldest.Valid {
+		before = oldest.Time.In(indonesiaLocation)
+	}
+
+	copied := 0
+	for {
+		batch, err := valuesBefore(ctx, conn, before, opts.BatchSize)
+		if err != nil {
+			return copied, err
+		}
+		if len(batch) == 0 {
+			return copied, nil
+		}
+		if !opts.DryRun {
+			if err := copyToTimescale(ctx, ts, batch); err != nil {
+				return copied, err
+			}
+		}
+		copied += len(batch)
+		before = batch[len(batch)-1].Time
+		log.Printf("Backfill TimescaleDB sampai %s: %d baris disalin", before.Format(dbTimeLayout), copied)
+
+		select {
+		case <-ctx.Done():
+			return copied, ctx.Err()
+		case <-time.After(opts.Pause):
+		}
+	}
+}
+
+type numericValue struct {
+	DeviceID string
+	timedValue
+}
+
+// valuesBefore returns up to limit Value rows created before the given time,
+// newest first. Rows sharing the oldest timestamp of a full batch are left to
+// the next one; when a whole batch shares it, every row at that time is
+// returned.
+func valuesBefore(ctx context.Context, conn *sql.DB, before time.Time, limit int) ([]numericValue, error) {
+	batch, err := scanNumericValues(conn.QueryContext(ctx, `
+        SELECT deviceId, numericValue, created FROM Value
+        WHERE created < ? AND numericValue IS NOT NULL
+        ORDER BY created DESC LIMIT ?`, before.Format(dbTimeLayout), limit))
+	if err != nil || len(batch) < limit {
+		return batch, err
+	}
+
+	last := batch[len(batch)-1].Time
+	n := len(batch)
+	for n > 0 && batch[n-1].Time.Equal(last) {
+		n--
+	}
+	if n > 0 {
+		return batch[:n], nil
+	}
+	return scanNumericValues(conn.QueryContext(ctx, `
+        SELECT deviceId, numericValue, created FROM Value
+        WHERE created = ? AND numericValue IS NOT NULL`, last.Format(dbTimeLayout)))
+}
+
+func scanNumericValues(rows *sql.Rows, err error) ([]numericValue, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batch []numericValue
+	for rows.Next() {
+		var v numericValue
+		if err := rows.Scan(&v.DeviceID, &v.Value, &v.Time); err != nil {
+			return nil, err
+		}
+		v.Time = localTime(v.Time)
+		batch = append(batch, v)
+	}
+	return batch, rows.Err()
+}
+
+func copyToTimescale(ctx context.Context, ts *sql.DB, batch []numericValue) error {
+	tx, err := ts.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, v := range batch {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO value (device_id, created, value) VALUES ($1, $2, $3)", v.DeviceID, v.Time, v.Value); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
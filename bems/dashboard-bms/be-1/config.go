@@ -0,0 +1,365 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5"
+	"gopkg.in/yaml.v3"
+
+	"platform"
+)
+
+/* KODE PROGRAM - KONFIGURASI */
+
+// duration reads Go duration strings ("15m", "5s") from YAML and env.
+type duration struct {
+	time.Duration
+}
+
+func (d *duration) UnmarshalYAML(value *yaml.Node) error {
+	parsed, err := time.ParseDuration(value.Value)
+	if err != nil {
+		return fmt.Errorf("durasi tidak valid %q", value.Value)
+	}
+	d.Duration = parsed
+	return nil
+}
+
+// config is everything be-1 needs at startup. Values come from the defaults
+// below, then the optional YAML file, then environment variables.
+type config struct {
+	HTTP struct {
+		Addr           string   `yaml:"addr"`
+		AllowedOrigins []string `yaml:"allowedOrigins"`
+		TrustedProxies []string `yaml:"trustedProxies"`
+		MetricsAddr    string   `yaml:"metricsAddr"`
+	} `yaml:"http"`
+	Database struct {
+		Driver  string `yaml:"driver"`
+		DSN     string `yaml:"dsn"`
+		Migrate bool   `yaml:"migrate"`
+	} `yaml:"database"`
+	Timeseries struct {
+		Driver string `yaml:"driver"`
+		DSN    string `yaml:"dsn"`
+	} `yaml:"timeseries"`
+	MQTT struct {
+		Broker   string `yaml:"broker"`
+		Username string `yaml:"username"`
+		Password string `yaml:"password"`
+		ClientID string `yaml:"clientId"`
+	} `yaml:"mqtt"`
+	Auth struct {
+		JWTSecret    string   `yaml:"jwtSecret"`
+		PublicRoutes []string `yaml:"publicRoutes"`
+		Admins       []string `yaml:"admins"`
+	} `yaml:"auth"`
+	Logs struct {
+		Dir        string   `yaml:"dir"`
+		Level      string   `yaml:"level"`
+		MaxSizeMB  int      `yaml:"maxSizeMB"`
+		MaxAge     duration `yaml:"maxAge"`
+		MaxBackups int      `yaml:"maxBackups"`
+	} `yaml:"logs"`
+	Monitoring struct {
+		StaleAfter          duration `yaml:"staleAfter"`
+		IAQBreakpointsFile  string   `yaml:"iaqBreakpointsFile"`
+		LightingTargetsFile string   `yaml:"lightingTargetsFile"`
+	} `yaml:"monitoring"`
+	SoftSensor struct {
+		Tolerance duration `yaml:"tolerance"`
+	} `yaml:"softSensor"`
+	Alerts struct {
+		EscalateAfter      duration `yaml:"escalateAfter"`
+		NotifyChannelsFile string   `yaml:"notifyChannelsFile"`
+	} `yaml:"alerts"`
+	Control struct {
+		AckTimeout       duration `yaml:"ackTimeout"`
+		Timezone         string   `yaml:"timezone"`
+		DemandConfigFile string   `yaml:"demandConfigFile"`
+	} `yaml:"control"`
+	Tracing struct {
+		Exporter    string  `yaml:"exporter"`
+		Endpoint    string  `yaml:"endpoint"`
+		SampleRatio float64 `yaml:"sampleRatio"`
+	} `yaml:"tracing"`
+}
+
+func defaultConfig() config {
+	var cfg config
+	cfg.HTTP.Addr = ":10004"
+	cfg.HTTP.MetricsAddr = ":9104"
+	cfg.HTTP.AllowedOrigins = []string{"http://10.46.7.51:10006", "http://localhost:10006", "http://172.35.0.7:10006"}
+	cfg.Database.Driver = "mysql"
+	cfg.Database.Migrate = true
+	cfg.Timeseries.Driver = "database"
+	cfg.MQTT.Broker = "mqtt://emqx-lb:1883"
+	cfg.Logs.Dir = "logs"
+	cfg.Logs.Level = "info"
+	cfg.Logs.MaxSizeMB = 50
+	cfg.Logs.MaxAge = duration{24 * time.Hour}
+	cfg.Logs.MaxBackups = 7
+	cfg.Monitoring.StaleAfter = duration{15 * time.Minute}
+	cfg.SoftSensor.Tolerance = duration{5 * time.Minute}
+	cfg.Alerts.EscalateAfter = duration{15 * time.Minute}
+	cfg.Control.AckTimeout = duration{5 * time.Second}
+	cfg.Control.Timezone = "Asia/Jakarta"
+	cfg.Tracing.Exporter = "none"
+	cfg.Tracing.Endpoint = "http://otel-collector:4318"
+	cfg.Tracing.SampleRatio = 1
+	return cfg
+}
+
+func splitList(s string) []string {
+	list := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// envOverrides maps each environment variable onto its config field.
+func (c *config) envOverrides() map[string]func(string) error {
+	str := func(field *string) func(string) error {
+		return func(v string) error { *field = v; return nil }
+	}
+	list := func(field *[]string) func(string) error {
+		return func(v string) error { *field = splitList(v); return nil }
+	}
+	boolean := func(field *bool) func(string) error {
+		return func(v string) error {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("nilai boolean tidak valid %q", v)
+			}
+			*field = b
+			return nil
+		}
+	}
+	dur := func(field *duration) func(string) error {
+		return func(v string) error {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("durasi tidak valid %q", v)
+			}
+			field.Duration = d
+			return nil
+		}
+	}
+	integer := func(field *int) func(string) error {
+		return func(v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("bilangan bulat tidak valid %q", v)
+			}
+			*field = n
+			return nil
+		}
+	}
+	number := func(field *float64) func(string) error {
+		return func(v string) error {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return fmt.Errorf("angka tidak valid %q", v)
+			}
+			*field = f
+			return nil
+		}
+	}
+
+	return map[string]func(string) error{
+		"HTTP_ADDR":              str(&c.HTTP.Addr),
+		"CORS_ALLOWED_ORIGINS":   list(&c.HTTP.AllowedOrigins),
+		"TRUSTED_PROXIES":        list(&c.HTTP.TrustedProxies),
+		"METRICS_ADDR":           str(&c.HTTP.MetricsAddr),
+		"DATABASE_DRIVER":        str(&c.Database.Driver),
+		"DATABASE_DSN":           str(&c.Database.DSN),
+		"DATABASE_MIGRATE":       boolean(&c.Database.Migrate),
+		"TIMESERIES_DRIVER":      str(&c.Timeseries.Driver),
+		"TIMESERIES_DSN":         str(&c.Timeseries.DSN),
+		"MQTT_BROKER":            str(&c.MQTT.Broker),
+		"MQTT_USERNAME":          str(&c.MQTT.Username),
+		"MQTT_PASSWORD":          str(&c.MQTT.Password),
+		"MQTT_CLIENT_ID":         str(&c.MQTT.ClientID),
+		"JWT_SECRET":             str(&c.Auth.JWTSecret),
+		"AUTH_PUBLIC_ROUTES":     list(&c.Auth.PublicRoutes),
+		"RBAC_ADMINS":            list(&c.Auth.Admins),
+		"LOG_DIR":                str(&c.Logs.Dir),
+		"LOG_LEVEL":              str(&c.Logs.Level),
+		"LOG_MAX_SIZE_MB":        integer(&c.Logs.MaxSizeMB),
+		"LOG_MAX_AGE":            dur(&c.Logs.MaxAge),
+		"LOG_MAX_BACKUPS":        integer(&c.Logs.MaxBackups),
+		"MONITORING_STALE_AFTER": dur(&c.Monitoring.StaleAfter),
+		"IAQ_BREAKPOINTS_FILE":   str(&c.Monitoring.IAQBreakpointsFile),
+		"LIGHTING_TARGETS_FILE":  str(&c.Monitoring.LightingTargetsFile),
+		"SOFT_SENSOR_TOLERANCE":  dur(&c.SoftSensor.Tolerance),
+		"ALERT_ESCALATE_AFTER":   dur(&c.Alerts.EscalateAfter),
+		"NOTIFY_CHANNELS_FILE":   str(&c.Alerts.NotifyChannelsFile),
+		"CONTROL_ACK_TIMEOUT":    dur(&c.Control.AckTimeout),
+		"BUILDING_TIMEZONE":      str(&c.Control.Timezone),
+		"DEMAND_CONFIG_FILE":     str(&c.Control.DemandConfigFile),
+		"TRACING_EXPORTER":       str(&c.Tracing.Exporter),
+		"TRACING_ENDPOINT":       str(&c.Tracing.Endpoint),
+		"TRACING_SAMPLE_RATIO":   number(&c.Tracing.SampleRatio),
+	}
+}
+
+// loadConfig builds the configuration from path (may be empty) and the
+// environment, then validates it.
+func loadConfig(path string) (config, error) {
+	cfg := defaultConfig()
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return cfg, err
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return cfg, fmt.Errorf("gagal membaca %s: %v", path, err)
+		}
+	}
+
+	var errs []error
+	for name, apply := range cfg.envOverrides() {
+		if v, ok := os.LookupEnv(name); ok {
+			if err := apply(v); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", name, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return cfg, errors.Join(errs...)
+	}
+	return cfg, cfg.validate()
+}
+
+// validate reports every invalid field at once.
+func (c *config) validate() error {
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.HTTP.Addr == "" {
+		fail("http.addr wajib diisi")
+	}
+	if c.HTTP.MetricsAddr != "" && c.HTTP.MetricsAddr == c.HTTP.Addr {
+		fail("http.metricsAddr harus berbeda dari http.addr")
+	}
+	if len(c.HTTP.AllowedOrigins) == 0 {
+		fail("http.allowedOrigins wajib diisi")
+	}
+	for _, origin := range c.HTTP.AllowedOrigins {
+		if u, err := url.Parse(origin); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			fail("origin tidak valid: %q", origin)
+		}
+	}
+	if _, err := parseTrustedProxies(c.HTTP.TrustedProxies); err != nil {
+		fail("http.trustedProxies: %v", err)
+	}
+
+	switch {
+	case c.Database.Driver != "mysql" && c.Database.Driver != "sqlite":
+		fail("database.driver harus mysql atau sqlite: %q", c.Database.Driver)
+	case c.Database.DSN == "":
+		fail("database.dsn wajib diisi (DATABASE_DSN)")
+	case c.Database.Driver == "mysql":
+		if dsn, err := mysql.ParseDSN(c.Database.DSN); err != nil {
+			fail("database.dsn tidak valid: %v", err)
+		} else {
+			// Query waktu di be-1 membutuhkan parseTime
+			dsn.ParseTime = true
+			c.Database.DSN = dsn.FormatDSN()
+		}
+	}
+
+	switch {
+	case c.Timeseries.Driver != "database" && c.Timeseries.Driver != "timescale":
+		fail("timeseries.driver harus database atau timescale: %q", c.Timeseries.Driver)
+	case c.Timeseries.Driver == "timescale" && c.Timeseries.DSN == "":
+		fail("timeseries.dsn wajib diisi untuk timescale (TIMESERIES_DSN)")
+	case c.Timeseries.Driver == "timescale":
+		if _, err := pgx.ParseConfig(c.Timeseries.DSN); err != nil {
+			fail("timeseries.dsn tidak valid: %v", err)
+		}
+	}
+
+	if u, err := url.Parse(c.MQTT.Broker); err != nil || u.Host == "" {
+		fail("mqtt.broker tidak valid: %q", c.MQTT.Broker)
+	} else if !map[string]bool{"mqtt": true, "tcp": true, "ssl": true, "tls": true, "ws": true, "wss": true}[u.Scheme] {
+		fail("skema mqtt.broker tidak didukung: %s", u.Scheme)
+	}
+
+	if len(c.Auth.PublicRoutes) > 0 {
+		if _, err := platform.ParsePublicRoutes(strings.Join(c.Auth.PublicRoutes, ",")); err != nil {
+			fail("auth.publicRoutes: %v", err)
+		}
+	}
+	if c.Logs.Dir == "" {
+		fail("logs.dir wajib diisi")
+	}
+	if _, err := platform.ParseLogLevel(c.Logs.Level); err != nil {
+		fail("logs.level: %v", err)
+	}
+	if c.Logs.MaxSizeMB <= 0 {
+		fail("logs.maxSizeMB harus lebih dari 0")
+	}
+	if c.Logs.MaxBackups < 0 {
+		fail("logs.maxBackups tidak boleh negatif")
+	}
+
+	for name, d := range map[string]duration{
+		"monitoring.staleAfter": c.Monitoring.StaleAfter,
+		"softSensor.tolerance":  c.SoftSensor.Tolerance,
+		"alerts.escalateAfter":  c.Alerts.EscalateAfter,
+		"control.ackTimeout":    c.Control.AckTimeout,
+		"logs.maxAge":           c.Logs.MaxAge,
+	} {
+		if d.Duration <= 0 {
+			fail("%s harus lebih dari 0", name)
+		}
+	}
+	if _, err := time.LoadLocation(c.Control.Timezone); err != nil {
+		fail("control.timezone tidak valid: %v", err)
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if u, err := url.Parse(c.Tracing.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			fail("tracing.endpoint harus URL OTLP/HTTP: %q", c.Tracing.Endpoint)
+		}
+	default:
+		fail("tracing.exporter harus none, stdout atau otlp: %q", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		fail("tracing.sampleRatio harus antara 0 dan 1")
+	}
+	for name, path := range map[string]string{
+		"monitoring.iaqBreakpointsFile":  c.Monitoring.IAQBreakpointsFile,
+		"monitoring.lightingTargetsFile": c.Monitoring.LightingTargetsFile,
+		"alerts.notifyChannelsFile":      c.Alerts.NotifyChannelsFile,
+		"control.demandConfigFile":       c.Control.DemandConfigFile,
+	} {
+		if path == "" {
+			continue
+		}
+		if _, err := os.Stat(path); err != nil {
+			fail("%s: %v", name, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/gorilla/mux"
+
+	"platform"
+)
+
+/* KODE PROGRAM - KONTROL SAKLAR PINTAR */
+
+// Commands go to control/{site}/{device}/set and devices answer on
+// control/{site}/{device}/ack echoing the command id.
+const (
+	controlCommandTopic = "control/%s/%s/set"
+	controlAckTopic     = "control/+/+/ack"
+)
+
+// controllableDevices are the Parameter aliases that accept commands. Their
+// state is what the Stat table records.
+var controllableDevices = map[string]bool{"lamp": true, "fan": true, "ac": true, "window": true}
+
+var controlAckTimeout = 5 * time.Second
+
+type controlCommand struct {
+	ID       string   `json:"id"`
+	State    string   `json:"state,omitempty"`
+	Setpoint *float64 `json:"setpoint,omitempty"`
+	IssuedAt string   `json:"issuedAt"`
+	Source   string   `json:"source"`
+}
+
+type controlAck struct {
+	ID       string   `json:"id"`
+	OK       bool     `json:"ok"`
+	State    string   `json:"state"`
+	Setpoint *float64 `json:"setpoint"`
+	Error    string   `json:"error"`
+}
+
+type controlResult struct {
+	Site          string   `json:"site"`
+	Device        string   `json:"device"`
+	CorrelationID string   `json:"correlationId"`
+	State         string   `json:"state"`
+	Setpoint      *float64 `json:"setpoint,omitempty"`
+	ConfirmedAt   string   `json:"confirmedAt"`
+}
+
+var (
+	errControlUnknownDevice = errors.New("perangkat tidak ditemukan")
+	errControlTimeout       = errors.New("perangkat tidak membalas sebelum batas waktu")
+	errControlRejected      = errors.New("perangkat menolak perintah")
+	errControlShed          = errors.New("perangkat sedang dilepas oleh pembatas beban puncak")
+)
+
+var (
+	pendingMu       sync.Mutex
+	pendingCommands = map[string]chan controlAck{}
+)
+
+func newCorrelationID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// controlAckHandler hands device acknowledgements to the waiting command.
+// Acks with an unknown id (late or duplicate) are dropped.
+func controlAckHandler(client mqtt.Client, msg mqtt.Message) {
+	var ack controlAck
+	if err := json.Unmarshal(msg.Payload(), &ack); err != nil {
+		log.Printf("Ack kontrol tidak valid di %s: %v", msg.Topic(), err)
+		return
+	}
+
+	pendingMu.Lock()
+	waiter, ok := pendingCommands[ack.ID]
+	delete(pendingCommands, ack.ID)
+	pendingMu.Unlock()
+
+	if ok {
+		waiter <- ack
+	}
+}
+
+// sendControlCommand publishes a command, waits for the matching ack and
+// records the confirmed on/off state in Stat.
+func sendControlCommand(ctx context.Context, siteAlias, deviceAlias string, cmd controlCommand) (controlResult, error) {
+	deviceId, err := getDeviceIdByAlias(ctx, siteAlias, deviceAlias)
+	if err != nil || deviceId == "" {
+		return controlResult{}, fmt.Errorf("%w: %s/%s", errControlUnknownDevice, siteAlias, deviceAlias)
+	}
+	if cmd.State == "on" && cmd.Source != "demand" && demand.isShed(siteAlias, deviceAlias) {
+		return controlResult{}, fmt.Errorf("%w: %s/%s", errControlShed, siteAlias, deviceAlias)
+	}
+	if mqttClient == nil || !mqttClient.IsConnected() {
+		return controlResult{}, fmt.Errorf("broker MQTT tidak terhubung")
+	}
+
+	cmd.ID = newCorrelationID()
+	cmd.IssuedAt = time.Now().In(indonesiaLocation).Format(time.RFC3339)
+	payload, err := json.Marshal(cmd)
+	if err != nil {
+		return controlResult{}, err
+	}
+
+	waiter := make(chan controlAck, 1)
+	pendingMu.Lock()
+	pendingCommands[cmd.ID] = waiter
+	pendingMu.Unlock()
+	defer func() {
+		pendingMu.Lock()
+		delete(pendingCommands, cmd.ID)
+		pendingMu.Unlock()
+	}()
+
+	topic := fmt.Sprintf(controlCommandTopic, siteAlias, deviceAlias)
+	token := mqttClient.Publish(topic, 1, false, payload)
+	if !token.WaitTimeout(controlAckTimeout) {
+		return controlResult{}, fmt.Errorf("timeout publish perintah ke %s", topic)
+	}
+	if err := token.Error(); err != nil {
+		return controlResult{}, fmt.Errorf("gagal publish perintah ke %s: %v", topic, err)
+	}
+	log.Printf("Perintah kontrol %s dikirim ke %s: %s", cmd.ID, topic, payload)
+
+	var ack controlAck
+	select {
+	case ack = <-waiter:
+	case <-time.After(controlAckTimeout):
+		return controlResult{}, errControlTimeout
+	case <-ctx.Done():
+		return controlResult{}, ctx.Err()
+	}
+	if !ack.OK {
+		return controlResult{}, fmt.Errorf("%w: %s", errControlRejected, ack.Error)
+	}
+
+	confirmed := time.Now().In(indonesiaLocation)
+	result := controlResult{
+		Site:          siteAlias,
+		Device:        deviceAlias,
+		CorrelationID: cmd.ID,
+		State:         ack.State,
+		Setpoint:      ack.Setpoint,
+		ConfirmedAt:   confirmed.Format(time.RFC3339),
+	}
+
+	if ack.State == "on" || ack.State == "off" {
+		stat := 0
+		if ack.State == "on" {
+			stat = 1
+		}
+		if err := repo.Stats.Insert(ctx, deviceId, float64(stat), confirmed); err != nil {
+			log.Printf("Gagal mencatat status %s/%s ke Stat: %v", siteAlias, deviceAlias, err)
+		}
+	}
+	return result, nil
+}
+
+type controlRequest struct {
+	State    string   `json:"state"`
+	Setpoint *float64 `json:"setpoint"`
+}
+
+func (c controlRequest) validate() error {
+	if c.State == "" && c.Setpoint == nil {
+		return fmt.Errorf("state atau setpoint wajib diisi")
+	}
+	if c.State != "" && c.State != "on" && c.State != "off" {
+		return fmt.Errorf("state harus on atau off")
+	}
+	return nil
+}
+
+// controlSource names the user behind an API command, e.g. "user:admin".
+func controlSource(r *http.Request) string {
+	if id, ok := platform.IdentityFrom(r); ok {
+		return "user:" + id.Subject
+	}
+	return "api"
+}
+
+func controlHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	siteAlias := vars["siteAlias"]
+	deviceAlias := vars["deviceAlias"]
+
+	if !controllableDevices[deviceAlias] {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Perangkat %s tidak dapat dikontrol", deviceAlias))
+		return
+	}
+
+	var req controlRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "body JSON tidak valid")
+		return
+	}
+	if err := req.validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := sendControlCommand(r.Context(), siteAlias, deviceAlias, controlCommand{
+		State:    req.State,
+		Setpoint: req.Setpoint,
+		Source:   controlSource(r),
+	})
+	switch {
+	case errors.Is(err, errControlUnknownDevice):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, errControlTimeout):
+		writeError(w, http.StatusGatewayTimeout, err.Error())
+	case errors.Is(err, errControlRejected), errors.Is(err, errControlShed):
+		writeError(w, http.StatusConflict, err.Error())
+	case err != nil:
+		log.Printf("Gagal mengontrol %s/%s: %v", siteAlias, deviceAlias, err)
+		writeError(w, http.StatusBadGateway, err.Error())
+	default:
+		writeJSON(w, http.StatusOK, result)
+	}
+}
@@ -0,0 +1,422 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"platform"
+)
+
+/* KODE PROGRAM - PEMBATASAN BEBAN PUNCAK */
+
+// sheddableLoad is a controllable device the demand controller may switch
+// off. Loads with a lower Priority are shed first and restored last.
+type sheddableLoad struct {
+	SiteAlias   string `json:"siteAlias"`
+	DeviceAlias string `json:"deviceAlias"`
+	Priority    int    `json:"priority"`
+}
+
+// demandConfig describes the contracted capacity. Demand is the sum over
+// Sites of the average power (Watt) within Window. Loads are shed once demand
+// reaches ShedAt × LimitW and restored below RestoreAt × LimitW, one load per
+// Step.
+type demandConfig struct {
+	LimitW    float64         `json:"limitW"`
+	ShedAt    float64         `json:"shedAt"`
+	RestoreAt float64         `json:"restoreAt"`
+	Window    string          `json:"window"`
+	Step      string          `json:"step"`
+	Sites     []string        `json:"sites"`
+	Parameter string          `json:"parameter"`
+	Loads     []sheddableLoad `json:"loads"`
+}
+
+type shedLoad struct {
+	sheddableLoad
+	ShedAt time.Time `json:"shedAt"`
+}
+
+type demandController struct {
+	mu         sync.Mutex
+	cfg        demandConfig
+	window     time.Duration
+	stepDelay  time.Duration
+	shed       []shedLoad
+	lastAction time.Time
+	lastStatus demandStatus
+}
+
+type demandStatus struct {
+	Enabled    bool               `json:"enabled"`
+	DemandW    float64            `json:"demandW"`
+	Sites      map[string]float64 `json:"sites"`
+	LimitW     float64            `json:"limitW"`
+	ShedW      float64            `json:"shedW"`
+	RestoreW   float64            `json:"restoreW"`
+	Window     string             `json:"window"`
+	State      string             `json:"state"`
+	Shed       []shedLoad         `json:"shed"`
+	MeasuredAt *time.Time         `json:"measuredAt"`
+}
+
+var demand = &demandController{}
+
+// loadDemandConfig reads the controller settings from a JSON file. Without
+// one the controller stays disabled.
+func loadDemandConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	cfg := demandConfig{
+		ShedAt:    0.9,
+		RestoreAt: 0.8,
+		Window:    "15m",
+		Step:      "2m",
+		Sites:     []string{"mcb1", "mcb2"},
+		Parameter: "power",
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("gagal membaca konfigurasi beban puncak %s: %v", path, err)
+	}
+
+	if cfg.LimitW <= 0 {
+		return fmt.Errorf("limitW wajib lebih dari 0")
+	}
+	if cfg.RestoreAt <= 0 || cfg.RestoreAt >= cfg.ShedAt || cfg.ShedAt > 1 {
+		return fmt.Errorf("harus berlaku 0 < restoreAt < shedAt <= 1")
+	}
+	window, err := time.ParseDuration(cfg.Window)
+	if err != nil || window <= 0 {
+		return fmt.Errorf("window tidak valid: %s", cfg.Window)
+	}
+	step, err := time.ParseDuration(cfg.Step)
+	if err != nil || step <= 0 {
+		return fmt.Errorf("step tidak valid: %s", cfg.Step)
+	}
+	for _, load := range cfg.Loads {
+		if err := validateSwitchTarget(load.SiteAlias, load.DeviceAlias, "off", nil); err != nil {
+			return err
+		}
+	}
+	sort.SliceStable(cfg.Loads, func(i, j int) bool { return cfg.Loads[i].Priority < cfg.Loads[j].Priority })
+
+	demand.mu.Lock()
+	defer demand.mu.Unlock()
+	demand.cfg = cfg
+	demand.window = window
+	demand.stepDelay = step
+	log.Printf("Berhasil memuat konfigurasi beban puncak dari %s (batas %.0f W, %d beban)", path, cfg.LimitW, len(cfg.Loads))
+	return nil
+}
+
+func (d *demandController) enabled() bool {
+	return d.cfg.LimitW > 0
+}
+
+// isShed reports whether a device is currently held off by the controller.
+func (d *demandController) isShed(siteAlias, deviceAlias string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, load := range d.shed {
+		if load.SiteAlias == siteAlias && load.DeviceAlias == deviceAlias {
+			return true
+		}
+	}
+	return false
+}
+
+// restoreShedLoads rebuilds the shed list from DemandEvent so loads switched
+// off before a restart are still restored afterwards.
+func (d *demandController) restoreShedLoads() error {
+	rows, err := queryDB(context.Background(), "demand_shed_loads", `
+        SELECT e.siteAlias, e.deviceAlias, e.created FROM DemandEvent e
+        WHERE e.action IN ('shed', 'restored')
+          AND e.id = (SELECT MAX(x.id) FROM DemandEvent x
+                      WHERE x.siteAlias = e.siteAlias AND x.deviceAlias = e.deviceAlias AND x.action IN ('shed', 'restored'))
+          AND e.action = 'shed'
+        ORDER BY e.id`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	priorities := map[string]int{}
+	for _, load := range d.cfg.Loads {
+		priorities[load.SiteAlias+"/"+load.DeviceAlias] = load.Priority
+	}
+	for rows.Next() {
+		var load shedLoad
+		if err := rows.Scan(&load.SiteAlias, &load.DeviceAlias, &load.ShedAt); err != nil {
+			return err
+		}
+		load.ShedAt = localTime(load.ShedAt)
+		load.Priority = priorities[load.SiteAlias+"/"+load.DeviceAlias]
+		d.shed = append(d.shed, load)
+	}
+	return rows.Err()
+}
+
+// measureDemand returns the rolling average power of each configured site.
+func measureDemand(cfg demandConfig, window time.Duration, now time.Time) (map[string]float64, error) {
+	ctx := context.Background()
+	sites := map[string]float64{}
+	for _, site := range cfg.Sites {
+		p, err := repo.Parameters.Find(ctx, site, cfg.Parameter)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		readings, err := repo.Values.Range(ctx, p.ID, now.Add(-window), now)
+		if err != nil {
+			return nil, err
+		}
+
+		var sum float64
+		for _, reading := range readings {
+			sum += reading.Value
+		}
+		if len(readings) > 0 {
+			sites[site] = sum / float64(len(readings))
+		}
+	}
+	return sites, nil
+}
+
+func logDemandEvent(action string, load sheddableLoad, demandW, limitW float64, correlationID, message string) {
+	_, err := execDB(context.Background(), "demand_event_insert", `
+        INSERT INTO DemandEvent (action, siteAlias, deviceAlias, priority, demandW, limitW, correlationId, message, created)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		action, load.SiteAlias, load.DeviceAlias, load.Priority, demandW, limitW,
+		nullableString(correlationID), nullableString(message), time.Now().In(indonesiaLocation).Format(dbTimeLayout))
+	if err != nil {
+		log.Printf("Gagal mencatat kejadian beban puncak: %v", err)
+	}
+}
+
+// step measures demand and sheds or restores at most one load. Between the
+// restore and shed thresholds nothing changes.
+func (d *demandController) step(now time.Time) error {
+	d.mu.Lock()
+	cfg, window, stepDelay, lastAction := d.cfg, d.window, d.stepDelay, d.lastAction
+	d.mu.Unlock()
+
+	sites, err := measureDemand(cfg, window, now)
+	if err != nil {
+		return err
+	}
+	var total float64
+	for _, w := range sites {
+		total += w
+	}
+
+	// Hanya beban yang sedang menyala yang dapat dilepas, agar pemulihan
+	// tidak menyalakan perangkat yang memang mati
+	var candidates []sheddableLoad
+	if total >= cfg.LimitW*cfg.ShedAt {
+		for _, load := range cfg.Loads {
+			if d.isShed(load.SiteAlias, load.DeviceAlias) {
+				continue
+			}
+			state, err := currentSwitchState(load.SiteAlias, load.DeviceAlias)
+			if err != nil {
+				return err
+			}
+			if state == "on" {
+				candidates = append(candidates, load)
+			}
+		}
+	}
+
+	state := "normal"
+	var target *sheddableLoad
+	shedding := false
+
+	d.mu.Lock()
+	switch {
+	case len(sites) == 0:
+		// Tanpa data daya tidak ada beban yang dilepas atau dipulihkan
+		state = "no_data"
+	case total >= cfg.LimitW*cfg.ShedAt:
+		state = "shedding"
+		if len(candidates) > 0 {
+			target = &candidates[0]
+			shedding = true
+		} else {
+			state = "exhausted"
+		}
+	case total <= cfg.LimitW*cfg.RestoreAt && len(d.shed) > 0:
+		// Beban dipulihkan berurutan dari prioritas tertinggi
+		state = "restoring"
+		highest := 0
+		for i, load := range d.shed {
+			if load.Priority > d.shed[highest].Priority {
+				highest = i
+			}
+		}
+		restore := d.shed[highest].sheddableLoad
+		target = &restore
+	case len(d.shed) > 0:
+		state = "holding"
+	}
+	measuredAt := now
+	d.lastStatus = demandStatus{
+		Enabled:    true,
+		DemandW:    total,
+		Sites:      sites,
+		LimitW:     cfg.LimitW,
+		ShedW:      cfg.LimitW * cfg.ShedAt,
+		RestoreW:   cfg.LimitW * cfg.RestoreAt,
+		Window:     cfg.Window,
+		State:      state,
+		MeasuredAt: &measuredAt,
+	}
+	d.mu.Unlock()
+
+	if target == nil || now.Sub(lastAction) < stepDelay {
+		return nil
+	}
+	load := *target
+
+	action, command := "restored", "on"
+	if shedding {
+		action, command = "shed", "off"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), controlAckTimeout+5*time.Second)
+	defer cancel()
+	result, err := sendControlCommand(ctx, load.SiteAlias, load.DeviceAlias, controlCommand{State: command, Source: "demand"})
+
+	d.mu.Lock()
+	d.lastAction = now
+	if err == nil {
+		if shedding {
+			d.shed = append(d.shed, shedLoad{sheddableLoad: load, ShedAt: now})
+		} else {
+			d.removeLocked(load)
+		}
+	}
+	d.mu.Unlock()
+
+	if err != nil {
+		log.Printf("Gagal %s beban %s/%s (demand %.0f W): %v", command, load.SiteAlias, load.DeviceAlias, total, err)
+		logDemandEvent("failed", load, total, cfg.LimitW, "", fmt.Sprintf("%s: %v", command, err))
+		return nil
+	}
+	log.Printf("Beban %s/%s %s (demand %.0f W, batas %.0f W)", load.SiteAlias, load.DeviceAlias, action, total, cfg.LimitW)
+	logDemandEvent(action, load, total, cfg.LimitW, result.CorrelationID, "")
+	return nil
+}
+
+func (d *demandController) removeLocked(load sheddableLoad) {
+	kept := d.shed[:0]
+	for _, s := range d.shed {
+		if s.SiteAlias != load.SiteAlias || s.DeviceAlias != load.DeviceAlias {
+			kept = append(kept, s)
+		}
+	}
+	d.shed = kept
+}
+
+// runDemandController evaluates demand once per interval while a limit is
+// configured.
+func runDemandController(ctx context.Context, interval time.Duration) {
+	if !demand.enabled() {
+		return
+	}
+	if err := demand.restoreShedLoads(); err != nil {
+		log.Printf("Gagal memuat beban yang sedang dilepas: %v", err)
+	}
+	for {
+		if err := demand.step(time.Now().In(indonesiaLocation)); err != nil {
+			log.Printf("Gagal menghitung beban puncak: %v", err)
+		}
+		if !sleepContext(ctx, interval) {
+			return
+		}
+	}
+}
+
+/* KODE PROGRAM - API BEBAN PUNCAK */
+
+func getDemandStatus(w http.ResponseWriter, r *http.Request) {
+	demand.mu.Lock()
+	status := demand.lastStatus
+	status.Enabled = demand.enabled()
+	status.Shed = append([]shedLoad{}, demand.shed...)
+	demand.mu.Unlock()
+
+	if status.Sites == nil {
+		status.Sites = map[string]float64{}
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+type demandEvent struct {
+	ID            int64     `json:"id"`
+	Action        string    `json:"action"`
+	SiteAlias     string    `json:"siteAlias"`
+	DeviceAlias   string    `json:"deviceAlias"`
+	Priority      int       `json:"priority"`
+	DemandW       float64   `json:"demandW"`
+	LimitW        float64   `json:"limitW"`
+	CorrelationID string    `json:"correlationId"`
+	Message       string    `json:"message"`
+	Created       time.Time `json:"created"`
+}
+
+func getDemandEvents(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseTimeRange(r, 7*24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scope, scopeArgs := siteCondition(r, platform.PermRead, "siteAlias")
+	query := `SELECT id, action, siteAlias, deviceAlias, priority, demandW, limitW, correlationId, message, created
+        FROM DemandEvent WHERE created >= ? AND created < ? AND ` + scope
+	args := append([]interface{}{from.Format(dbTimeLayout), to.Format(dbTimeLayout)}, scopeArgs...)
+	if action := r.URL.Query().Get("action"); action != "" {
+		query += " AND action = ?"
+		args = append(args, action)
+	}
+
+	rows, err := queryDB(r.Context(), "demand_events", query+" ORDER BY created DESC LIMIT 1000", args...)
+	if err != nil {
+		log.Printf("Error querying demand events: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil kejadian beban puncak")
+		return
+	}
+	defer rows.Close()
+
+	events := []demandEvent{}
+	for rows.Next() {
+		var e demandEvent
+		var correlationID, message sql.NullString
+		if err := rows.Scan(&e.ID, &e.Action, &e.SiteAlias, &e.DeviceAlias, &e.Priority, &e.DemandW, &e.LimitW,
+			&correlationID, &message, &e.Created); err != nil {
+			log.Printf("Error scanning demand event: %v", err)
+			writeError(w, http.StatusInternalServerError, "Gagal membaca kejadian beban puncak")
+			return
+		}
+		e.CorrelationID = correlationID.String
+		e.Message = message.String
+		e.Created = localTime(e.Created)
+		events = append(events, e)
+	}
+	writeJSON(w, http.StatusOK, events)
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/* KODE PROGRAM - KESEHATAN DAN SHUTDOWN */
+
+// readinessTimeout bounds each dependency check of /readyz.
+const readinessTimeout = 2 * time.Second
+
+// shutdownTimeout is how long in-flight requests and inserts may take to
+// finish after SIGTERM before the process exits anyway.
+const shutdownTimeout = 20 * time.Second
+
+// pendingInserts tracks MQTT readings that are still being written, so a
+// shutdown waits for them before closing the database.
+var pendingInserts sync.WaitGroup
+
+// background is cancelled when shutdown starts; the periodic jobs stop at
+// their next check and backgroundLoops tracks them until they have returned.
+var background, stopBackground = context.WithCancel(context.Background())
+var backgroundLoops sync.WaitGroup
+
+// pendingNotifications tracks alert notifications still being delivered.
+var pendingNotifications sync.WaitGroup
+
+// startLoop runs a periodic job until background is cancelled.
+func startLoop(loop func(ctx context.Context, interval time.Duration), interval time.Duration) {
+	backgroundLoops.Add(1)
+	go func() {
+		defer backgroundLoops.Done()
+		loop(background, interval)
+	}()
+}
+
+// sleepContext waits for d and reports false when ctx ends first.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// waitGroup waits for wg until ctx ends and reports whether it finished.
+func waitGroup(ctx context.Context, wg *sync.WaitGroup) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// shuttingDown makes /readyz fail as soon as shutdown starts, before the
+// listener is closed.
+var shuttingDown atomic.Bool
+
+type healthCheck struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+func checkResult(err error) healthCheck {
+	if err != nil {
+		return healthCheck{OK: false, Error: err.Error()}
+	}
+	return healthCheck{OK: true}
+}
+
+func checkDatabase(ctx context.Context) error {
+	if db == nil {
+		return errors.New("belum terhubung")
+	}
+	return db.PingContext(ctx)
+}
+
+func checkMQTT() error {
+	if mqttClient == nil || !mqttClient.IsConnectionOpen() {
+		return errors.New("tidak terhubung ke broker")
+	}
+	return nil
+}
+
+// healthHandler answers liveness probes: the process is up and serving.
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// readyHandler answers 200 only when the database (and TimescaleDB, when
+// configured) and MQTT broker are reachable and the service is not shutting
+// down.
+func readyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	checks := map[string]healthCheck{
+		"database": checkResult(checkDatabase(ctx)),
+		"mqtt":     checkResult(checkMQTT()),
+	}
+	if timeseriesDB != nil {
+		checks["timeseries"] = checkResult(timeseriesDB.PingContext(ctx))
+	}
+	status, code := "ready", http.StatusOK
+	for _, c := range checks {
+		if !c.OK {
+			status, code = "not_ready", http.StatusServiceUnavailable
+		}
+	}
+	if shuttingDown.Load() {
+		status, code = "shutting_down", http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, map[string]interface{}{"status": status, "checks": checks})
+}
+
+// probe performs the container health check: GET url, success on 2xx.
+func probe(url string) error {
+	client := http.Client{Timeout: 2 * readinessTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s menjawab %s", url, resp.Status)
+	}
+	return nil
+}
+
+// shutdown drains the service in order: stop accepting requests, stop the
+// periodic jobs, stop receiving readings, wait for pending inserts and
+// notifications, then close the database.
+func shutdown(servers ...*http.Server) {
+	shuttingDown.Store(true)
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	for _, server := range servers {
+		if err := server.Shutdown(ctx); err != nil {
+			log.Printf("Gagal menghentikan server HTTP %s dengan rapi: %v", server.Addr, err)
+		}
+	}
+
+	// Job berkala masih butuh MQTT untuk menyelesaikan perintah yang sedang berjalan
+	stopBackground()
+	if waitGroup(ctx, &backgroundLoops) {
+		log.Println("Semua job berkala telah berhenti")
+	} else {
+		log.Println("Batas waktu shutdown tercapai, sebagian job berkala masih berjalan")
+	}
+
+	if mqttClient != nil && mqttClient.IsConnectionOpen() {
+		if token := mqttClient.Unsubscribe("monitoring/sensor", controlAckTopic); !token.WaitTimeout(readinessTimeout) || token.Error() != nil {
+			log.Printf("Gagal unsubscribe topik MQTT: %v", token.Error())
+		}
+		mqttClient.Disconnect(250)
+		log.Println("Koneksi MQTT ditutup")
+	}
+
+	if waitGroup(ctx, &pendingInserts) {
+		log.Println("Semua data sensor tertunda telah disimpan")
+	} else {
+		log.Println("Batas waktu shutdown tercapai, sebagian data sensor mungkin belum tersimpan")
+	}
+	// Alert dari data sensor terakhir baru dikirim setelah insert selesai
+	if waitGroup(ctx, &pendingNotifications) {
+		log.Println("Semua notifikasi alert telah dikirim")
+	} else {
+		log.Println("Batas waktu shutdown tercapai, sebagian notifikasi alert mungkin belum terkirim")
+	}
+
+	if traceProvider != nil {
+		if err := traceProvider.Shutdown(ctx); err != nil {
+			log.Printf("Gagal mengirim sisa span tracing: %v", err)
+		}
+	}
+
+	if db != nil {
+		if err := db.Close(); err != nil {
+			log.Printf("Gagal menutup basis data: %v", err)
+		}
+	}
+	if timeseriesDB != nil {
+		if err := timeseriesDB.Close(); err != nil {
+			log.Printf("Gagal menutup TimescaleDB: %v", err)
+		}
+	}
+	log.Println("Server berhenti")
+	if logFile != nil {
+		logFile.Close()
+	}
+}
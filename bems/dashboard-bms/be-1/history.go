@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+/* KODE PROGRAM - OPSI RENTANG & AGREGASI GRAFIK */
+
+// historyOptions are the range, aggregation and downsampling options shared
+// by the sensor and prediction history endpoints. Without any of the query
+// parameters the endpoints keep returning the latest 30 rows.
+type historyOptions struct {
+	Ranged   bool
+	From     time.Time
+	To       time.Time
+	Interval time.Duration
+	Agg      string
+}
+
+var historyAggregations = map[string]bool{"avg": true, "min": true, "max": true, "sum": true, "first": true, "last": true, "count": true}
+
+// parseHistoryOptions reads from/to, agg, interval and points. points picks an
+// interval that yields at most that many buckets over the range.
+func parseHistoryOptions(r *http.Request) (historyOptions, error) {
+	q := r.URL.Query()
+	opts := historyOptions{}
+	for _, name := range []string{"from", "to", "interval", "points", "agg"} {
+		if q.Get(name) != "" {
+			opts.Ranged = true
+		}
+	}
+	if !opts.Ranged {
+		return opts, nil
+	}
+
+	var err error
+	if opts.From, opts.To, err = parseTimeRange(r, 24*time.Hour); err != nil {
+		return opts, err
+	}
+	if opts.Interval, err = parseDuration(r, "interval", 0); err != nil {
+		return opts, err
+	}
+
+	if s := q.Get("points"); s != "" {
+		points, err := strconv.Atoi(s)
+		if err != nil || points <= 0 {
+			return opts, fmt.Errorf("points tidak valid: %s", s)
+		}
+		if opts.Interval == 0 {
+			opts.Interval = opts.To.Sub(opts.From) / time.Duration(points)
+			if opts.Interval < time.Second {
+				opts.Interval = time.Second
+			}
+			opts.Interval = opts.Interval.Round(time.Second)
+		}
+	}
+
+	opts.Agg = strings.ToLower(q.Get("agg"))
+	if opts.Agg == "" && opts.Interval > 0 {
+		opts.Agg = "avg"
+	}
+	if opts.Agg != "" && !historyAggregations[opts.Agg] {
+		return opts, fmt.Errorf("agg tidak dikenal: %s", opts.Agg)
+	}
+	if opts.Agg != "" && opts.Interval == 0 {
+		opts.Interval = opts.To.Sub(opts.From)
+	}
+	return opts, nil
+}
+
+// aggregateSeries buckets a time-ordered series by opts.Interval. Points with
+// different labels never share a bucket, so a model switch starts a new one.
+func aggregateSeries(series []timedValue, opts historyOptions) []timedValue {
+	if opts.Interval == 0 || opts.Agg == "" {
+		return series
+	}
+
+	type bucketKey struct {
+		index int64
+		label string
+	}
+	type bucket struct {
+		start                      time.Time
+		first, last, min, max, sum float64
+		count                      int
+	}
+
+	buckets := map[bucketKey]*bucket{}
+	var order []bucketKey
+	for _, point := range series {
+		key := bucketKey{int64(point.Time.Sub(opts.From) / opts.Interval), point.Label}
+		b := buckets[key]
+		if b == nil {
+			b = &bucket{start: opts.From.Add(time.Duration(key.index) * opts.Interval), first: point.Value, min: point.Value, max: point.Value}
+			buckets[key] = b
+			order = append(order, key)
+		}
+		b.last = point.Value
+		b.sum += point.Value
+		b.count++
+		if point.Value < b.min {
+			b.min = point.Value
+		}
+		if point.Value > b.max {
+			b.max = point.Value
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool { return order[i].index < order[j].index })
+
+	result := make([]timedValue, 0, len(order))
+	for _, key := range order {
+		b := buckets[key]
+		value := b.sum / float64(b.count)
+		switch opts.Agg {
+		case "min":
+			value = b.min
+		case "max":
+			value = b.max
+		case "sum":
+			value = b.sum
+		case "first":
+			value = b.first
+		case "last":
+			value = b.last
+		case "count":
+			value = float64(b.count)
+		}
+		result = append(result, timedValue{Time: b.start, Value: value, Label: key.label})
+	}
+	return result
+}
+
+// historyPoints converts a series into the {value, date, time} rows used by
+// the chart endpoints.
+func historyPoints(series []timedValue, labelKey string) []map[string]interface{} {
+	data := make([]map[string]interface{}, 0, len(series))
+	for _, point := range series {
+		row := map[string]interface{}{
+			"value": point.Value,
+			"date":  point.Time.Format("2006/01/02"),
+			"time":  point.Time.Format("15:04:05"),
+		}
+		if labelKey != "" {
+			row[labelKey] = point.Label
+		}
+		data = append(data, row)
+	}
+	return data
+}
+
+// loadSeries reads the readings of one device, either the latest 30 or the
+// rows inside the requested range.
+func loadSeries(ctx context.Context, readings seriesRepository, deviceId string, opts historyOptions) ([]timedValue, error) {
+	if opts.Ranged {
+		return readings.Range(ctx, deviceId, opts.From, opts.To)
+	}
+	return readings.Recent(ctx, deviceId, 30)
+}
+
+// getRangeData lets a backend with pre-aggregated readings answer bucketed
+// ranges directly and falls back to aggregating raw rows.
+func getRangeData(ctx context.Context, deviceId string, opts historyOptions) ([]map[string]interface{}, error) {
+	if aggregator, ok := repo.Values.(seriesAggregator); ok {
+		series, ok, err := aggregator.Aggregate(ctx, deviceId, opts)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return historyPoints(series, ""), nil
+		}
+	}
+	series, err := loadSeries(ctx, repo.Values, deviceId, opts)
+	if err != nil {
+		return nil, err
+	}
+	return historyPoints(aggregateSeries(series, opts), ""), nil
+}
+
+/* KODE PROGRAM - GRAFIK HISTORIS PREDIKSI */
+
+// getPredictHistory charts the Predict rows of a soft-sensor parameter. Each
+// point carries the model that was selected for the site at that time.
+func getPredictHistory(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	siteAlias := vars["siteAlias"]
+	parameter := strings.TrimPrefix(vars["parameter"], softSensorPrefix)
+
+	opts, err := parseHistoryOptions(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	siteId, err := repo.Sites.IDByAlias(r.Context(), siteAlias)
+	if err != nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Lokasi tidak ditemukan %s", siteAlias))
+		return
+	}
+
+	deviceId, err := getDeviceIdByAlias(r.Context(), siteAlias, softSensorPrefix+parameter)
+	if err != nil || deviceId == "" {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Parameter soft sensor tidak ditemukan %s", parameter))
+		return
+	}
+
+	series, err := loadSeries(r.Context(), repo.Predictions, deviceId, opts)
+	if err != nil {
+		log.Printf("Error querying predictions: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil data dari database")
+		return
+	}
+
+	timeline, err := repo.Models.Selections(r.Context(), siteId, parameter)
+	if err != nil {
+		log.Printf("Error querying model selections: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil data model")
+		return
+	}
+	for i := range series {
+		series[i].Label = activeModel(timeline, series[i].Time)
+	}
+
+	writeJSON(w, http.StatusOK, historyPoints(aggregateSeries(series, opts), "model"))
+}
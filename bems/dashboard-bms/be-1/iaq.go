@@ -0,0 +1,386 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+/* KODE PROGRAM - INDEKS KUALITAS UDARA (IAQ) */
+
+// iaqBand is one row of a breakpoint table: concentrations between CLow and
+// CHigh map linearly onto index values between ILow and IHigh.
+type iaqBand struct {
+	CLow     float64 `json:"cLow"`
+	CHigh    float64 `json:"cHigh"`
+	ILow     float64 `json:"iLow"`
+	IHigh    float64 `json:"iHigh"`
+	Category string  `json:"category"`
+}
+
+// iaqScale is a named set of breakpoint tables keyed by Parameter alias.
+type iaqScale struct {
+	Name       string               `json:"name"`
+	Pollutants map[string][]iaqBand `json:"pollutants"`
+}
+
+const defaultIAQScale = "ispu"
+
+// Neither ISPU nor US AQI defines CO2, so both scales carry CO2 bands based on
+// the usual indoor ventilation guidance (800/1000/1500 ppm).
+var iaqScales = map[string]iaqScale{
+	"ispu": {
+		Name: "ISPU (Permen LHK P.14/2020)",
+		Pollutants: map[string][]iaqBand{
+			"pm25": {
+				{0, 15.5, 0, 50, "Baik"},
+				{15.5, 55.4, 51, 100, "Sedang"},
+				{55.4, 150.4, 101, 200, "Tidak Sehat"},
+				{150.4, 250.4, 201, 300, "Sangat Tidak Sehat"},
+				{250.4, 500, 301, 500, "Berbahaya"},
+			},
+			"pm10": {
+				{0, 50, 0, 50, "Baik"},
+				{50, 150, 51, 100, "Sedang"},
+				{150, 350, 101, 200, "Tidak Sehat"},
+				{350, 420, 201, 300, "Sangat Tidak Sehat"},
+				{420, 500, 301, 500, "Berbahaya"},
+			},
+			"co2": {
+				{0, 800, 0, 50, "Baik"},
+				{800, 1000, 51, 100, "Sedang"},
+				{1000, 1500, 101, 200, "Tidak Sehat"},
+				{1500, 2500, 201, 300, "Sangat Tidak Sehat"},
+				{2500, 5000, 301, 500, "Berbahaya"},
+			},
+		},
+	},
+	"aqi": {
+		Name: "US EPA AQI",
+		Pollutants: map[string][]iaqBand{
+			"pm25": {
+				{0, 9.0, 0, 50, "Good"},
+				{9.1, 35.4, 51, 100, "Moderate"},
+				{35.5, 55.4, 101, 150, "Unhealthy for Sensitive Groups"},
+				{55.5, 125.4, 151, 200, "Unhealthy"},
+				{125.5, 225.4, 201, 300, "Very Unhealthy"},
+				{225.5, 325.4, 301, 500, "Hazardous"},
+			},
+			"pm10": {
+				{0, 54, 0, 50, "Good"},
+				{55, 154, 51, 100, "Moderate"},
+				{155, 254, 101, 150, "Unhealthy for Sensitive Groups"},
+				{255, 354, 151, 200, "Unhealthy"},
+				{355, 424, 201, 300, "Very Unhealthy"},
+				{425, 604, 301, 500, "Hazardous"},
+			},
+			"co2": {
+				{0, 800, 0, 50, "Good"},
+				{801, 1000, 51, 100, "Moderate"},
+				{1001, 1500, 101, 150, "Unhealthy for Sensitive Groups"},
+				{1501, 2000, 151, 200, "Unhealthy"},
+				{2001, 5000, 201, 300, "Very Unhealthy"},
+				{5001, 10000, 301, 500, "Hazardous"},
+			},
+		},
+	},
+}
+
+// loadIAQScales merges breakpoint tables from a JSON file of the form
+// {"<scale>": {"name": ..., "pollutants": {"pm25": [...]}}} into iaqScales.
+// A scale present in the file replaces the built-in one of the same key.
+func loadIAQScales(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var scales map[string]iaqScale
+	if err := json.Unmarshal(data, &scales); err != nil {
+		return fmt.Errorf("gagal membaca tabel breakpoint %s: %v", path, err)
+	}
+
+	for key, scale := range scales {
+		for pollutant, bands := range scale.Pollutants {
+			if len(bands) == 0 {
+				return fmt.Errorf("skala %s: tabel %s kosong", key, pollutant)
+			}
+			sort.Slice(bands, func(i, j int) bool { return bands[i].CLow < bands[j].CLow })
+		}
+		iaqScales[key] = scale
+	}
+	log.Printf("Berhasil memuat %d skala IAQ dari %s", len(scales), path)
+	return nil
+}
+
+// subIndex maps a concentration onto the scale. Values between two bands fall
+// into the upper one and values above the last band are capped at its top.
+func (s iaqScale) subIndex(pollutant string, c float64) (float64, string, bool) {
+	bands, ok := s.Pollutants[pollutant]
+	if !ok || len(bands) == 0 || c < 0 || math.IsNaN(c) {
+		return 0, "", false
+	}
+
+	for _, b := range bands {
+		if c <= b.CHigh {
+			if c < b.CLow {
+				c = b.CLow
+			}
+			if b.CHigh == b.CLow {
+				return b.IHigh, b.Category, true
+			}
+			return (b.IHigh-b.ILow)/(b.CHigh-b.CLow)*(c-b.CLow) + b.ILow, b.Category, true
+		}
+	}
+
+	last := bands[len(bands)-1]
+	return last.IHigh, last.Category, true
+}
+
+func (s iaqScale) pollutantAliases() []string {
+	aliases := make([]string, 0, len(s.Pollutants))
+	for alias := range s.Pollutants {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+type pollutantIndex struct {
+	Value    float64 `json:"value"`
+	Index    int     `json:"index"`
+	Category string  `json:"category"`
+}
+
+type iaqResult struct {
+	Site       string                    `json:"site,omitempty"`
+	Time       string                    `json:"time,omitempty"`
+	Scale      string                    `json:"scale"`
+	Index      int                       `json:"index"`
+	Dominant   string                    `json:"dominant"`
+	Category   string                    `json:"category"`
+	Pollutants map[string]pollutantIndex `json:"pollutants"`
+}
+
+// evaluate computes the overall index as the highest sub-index. The pollutant
+// that produced it is reported as dominant.
+func (s iaqScale) evaluate(scaleKey string, values map[string]float64) (iaqResult, bool) {
+	result := iaqResult{Scale: scaleKey, Index: -1, Pollutants: map[string]pollutantIndex{}}
+
+	aliases := make([]string, 0, len(values))
+	for alias := range values {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	for _, alias := range aliases {
+		index, category, ok := s.subIndex(alias, values[alias])
+		if !ok {
+			continue
+		}
+		rounded := int(math.Round(index))
+		result.Pollutants[alias] = pollutantIndex{Value: values[alias], Index: rounded, Category: category}
+		if rounded > result.Index {
+			result.Index = rounded
+			result.Dominant = alias
+			result.Category = category
+		}
+	}
+
+	return result, result.Index >= 0
+}
+
+func iaqScaleFromRequest(r *http.Request) (string, iaqScale, error) {
+	key := strings.ToLower(r.URL.Query().Get("scale"))
+	if key == "" {
+		key = defaultIAQScale
+	}
+	scale, ok := iaqScales[key]
+	if !ok {
+		return "", iaqScale{}, fmt.Errorf("skala IAQ tidak dikenal: %s", key)
+	}
+	return key, scale, nil
+}
+
+// pollutantParameters returns the Parameters whose alias is in aliases. An
+// empty siteAlias selects every site.
+func pollutantParameters(ctx context.Context, siteAlias string, aliases []string) ([]parameterRecord, error) {
+	var parameters []parameterRecord
+	var err error
+	if siteAlias != "" {
+		parameters, err = repo.Parameters.BySite(ctx, siteAlias)
+	} else {
+		parameters, err = repo.Parameters.List(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]bool{}
+	for _, alias := range aliases {
+		wanted[alias] = true
+	}
+	var pollutants []parameterRecord
+	for _, p := range parameters {
+		if wanted[p.Alias] {
+			pollutants = append(pollutants, p)
+		}
+	}
+	return pollutants, nil
+}
+
+// latestPollutantValues returns the most recent reading of every pollutant in
+// aliases, grouped by site alias. An empty siteAlias selects every site.
+func latestPollutantValues(ctx context.Context, siteAlias string, aliases []string) (map[string]map[string]float64, map[string]time.Time, error) {
+	pollutants, err := pollutantParameters(ctx, siteAlias, aliases)
+	if err != nil {
+		return nil, nil, err
+	}
+	latest, err := repo.Values.Latest(ctx, parameterIds(pollutants))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := map[string]map[string]float64{}
+	measured := map[string]time.Time{}
+	for _, p := range pollutants {
+		reading, ok := latest[p.ID]
+		if !ok {
+			continue
+		}
+		if values[p.SiteAlias] == nil {
+			values[p.SiteAlias] = map[string]float64{}
+		}
+		values[p.SiteAlias][p.Alias] = reading.Value
+		if reading.Time.After(measured[p.SiteAlias]) {
+			measured[p.SiteAlias] = reading.Time
+		}
+	}
+	return values, measured, nil
+}
+
+func getIAQ(w http.ResponseWriter, r *http.Request) {
+	roomId := mux.Vars(r)["roomId"]
+
+	scaleKey, scale, err := iaqScaleFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	values, measured, err := latestPollutantValues(r.Context(), roomId, scale.pollutantAliases())
+	if err != nil {
+		log.Printf("Error fetching IAQ data: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil data dari database")
+		return
+	}
+
+	sites := make([]string, 0, len(values))
+	for site := range values {
+		sites = append(sites, site)
+	}
+	sort.Strings(sites)
+
+	results := []iaqResult{}
+	for _, site := range sites {
+		result, ok := scale.evaluate(scaleKey, values[site])
+		if !ok {
+			continue
+		}
+		result.Site = site
+		result.Time = measured[site].Format("2006-01-02 15:04:05")
+		results = append(results, result)
+	}
+
+	if roomId == "" {
+		writeJSON(w, http.StatusOK, results)
+		return
+	}
+	if len(results) == 0 {
+		writeError(w, http.StatusNotFound, "Tidak ada data polutan untuk lokasi ini")
+		return
+	}
+	writeJSON(w, http.StatusOK, results[0])
+}
+
+func getIAQHistory(w http.ResponseWriter, r *http.Request) {
+	roomId := mux.Vars(r)["roomId"]
+
+	scaleKey, scale, err := iaqScaleFromRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	from, to, err := parseTimeRange(r, 24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	interval, err := parseDuration(r, "interval", time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pollutants, err := pollutantParameters(r.Context(), roomId, scale.pollutantAliases())
+	if err != nil {
+		log.Printf("Error fetching IAQ history: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil data dari database")
+		return
+	}
+
+	// Rata-rata tiap polutan per interval
+	type accumulator struct{ sum, count float64 }
+	buckets := map[int64]map[string]*accumulator{}
+	for _, p := range pollutants {
+		readings, err := repo.Values.Range(r.Context(), p.ID, from, to)
+		if err != nil {
+			log.Printf("Error fetching IAQ history: %v", err)
+			writeError(w, http.StatusInternalServerError, "Gagal mengambil data dari database")
+			return
+		}
+		for _, reading := range readings {
+			bucket := int64(reading.Time.Sub(from) / interval)
+			if buckets[bucket] == nil {
+				buckets[bucket] = map[string]*accumulator{}
+			}
+			if buckets[bucket][p.Alias] == nil {
+				buckets[bucket][p.Alias] = &accumulator{}
+			}
+			buckets[bucket][p.Alias].sum += reading.Value
+			buckets[bucket][p.Alias].count++
+		}
+	}
+
+	keys := make([]int64, 0, len(buckets))
+	for key := range buckets {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	results := []iaqResult{}
+	for _, key := range keys {
+		averages := map[string]float64{}
+		for pollutant, acc := range buckets[key] {
+			averages[pollutant] = acc.sum / acc.count
+		}
+		result, ok := scale.evaluate(scaleKey, averages)
+		if !ok {
+			continue
+		}
+		result.Site = roomId
+		result.Time = from.Add(time.Duration(key) * interval).Format("2006-01-02 15:04:05")
+		results = append(results, result)
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+/* KODE PROGRAM - KEPATUHAN PENCAHAYAAN */
+
+// occupancySchedule describes when rooms are considered occupied. Days uses
+// time.Weekday numbering (0 = Minggu) and Start/End are "HH:MM" local times.
+type occupancySchedule struct {
+	Days  []int  `json:"days"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// lightingConfig maps sites onto room types and room types onto the target
+// illuminance (lux) they must reach while occupied.
+type lightingConfig struct {
+	RoomTypes map[string]float64 `json:"roomTypes"`
+	Sites     map[string]string  `json:"sites"`
+	Occupied  occupancySchedule  `json:"occupied"`
+}
+
+// Target default mengikuti rekomendasi SNI 6197:2011.
+var lightingTargets = lightingConfig{
+	RoomTypes: map[string]float64{
+		"kelas":        350,
+		"laboratorium": 500,
+		"kantor":       350,
+		"perpustakaan": 300,
+		"rapat":        300,
+	},
+	Sites: map[string]string{
+		"tn_1":    "kelas",
+		"tn_2":    "kelas",
+		"tn_3":    "kelas",
+		"tn_4":    "kelas",
+		"tn_5":    "kelas",
+		"tn_6":    "kelas",
+		"tn_7":    "kelas",
+		"indoor8": "kelas",
+		"sstk":    "laboratorium",
+	},
+	Occupied: occupancySchedule{
+		Days:  []int{1, 2, 3, 4, 5},
+		Start: "07:00",
+		End:   "17:00",
+	},
+}
+
+// loadLightingConfig overrides lightingTargets from a JSON file. Sections that
+// are missing from the file keep their defaults.
+func loadLightingConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var cfg lightingConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("gagal membaca konfigurasi pencahayaan %s: %v", path, err)
+	}
+
+	if cfg.RoomTypes != nil {
+		lightingTargets.RoomTypes = cfg.RoomTypes
+	}
+	if cfg.Sites != nil {
+		lightingTargets.Sites = cfg.Sites
+	}
+	if cfg.Occupied.Start != "" || cfg.Occupied.End != "" || cfg.Occupied.Days != nil {
+		lightingTargets.Occupied = cfg.Occupied
+	}
+
+	if _, _, err := lightingTargets.Occupied.window(); err != nil {
+		return err
+	}
+	for site, roomType := range lightingTargets.Sites {
+		if _, ok := lightingTargets.RoomTypes[roomType]; !ok {
+			return fmt.Errorf("lokasi %s memakai tipe ruang %s yang tidak memiliki target", site, roomType)
+		}
+	}
+	log.Printf("Berhasil memuat konfigurasi pencahayaan dari %s", path)
+	return nil
+}
+
+// parseClock converts "HH:MM" into minutes after midnight.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("format jam tidak valid: %s", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (o occupancySchedule) window() (int, int, error) {
+	start, err := parseClock(o.Start)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseClock(o.End)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end <= start {
+		return 0, 0, fmt.Errorf("jam selesai harus setelah jam mulai: %s-%s", o.Start, o.End)
+	}
+	return start, end, nil
+}
+
+// occupied reports whether the hour starting at t lies inside the schedule.
+func (o occupancySchedule) occupied(t time.Time) bool {
+	start, end, err := o.window()
+	if err != nil {
+		return false
+	}
+
+	dayMatch := false
+	for _, day := range o.Days {
+		if time.Weekday(day) == t.Weekday() {
+			dayMatch = true
+			break
+		}
+	}
+	minute := t.Hour()*60 + t.Minute()
+	return dayMatch && minute >= start && minute < end
+}
+
+// hourlyAverages averages one parameter of a site per local clock hour.
+func hourlyAverages(ctx context.Context, siteAlias, parameterAlias string, from, to time.Time) (map[time.Time]float64, error) {
+	p, err := repo.Parameters.Find(ctx, siteAlias, parameterAlias)
+	if err == sql.ErrNoRows {
+		return map[time.Time]float64{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	readings, err := repo.Values.Range(ctx, p.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	sums := map[time.Time]float64{}
+	counts := map[time.Time]float64{}
+	for _, reading := range readings {
+		hour := reading.Time.Truncate(time.Hour)
+		sums[hour] += reading.Value
+		counts[hour]++
+	}
+
+	averages := make(map[time.Time]float64, len(sums))
+	for hour, sum := range sums {
+		averages[hour] = sum / counts[hour]
+	}
+	return averages, nil
+}
+
+type lightingCompliance struct {
+	Site             string  `json:"site"`
+	Date             string  `json:"date,omitempty"`
+	RoomType         string  `json:"roomType"`
+	TargetLux        float64 `json:"targetLux"`
+	OccupiedHours    int     `json:"occupiedHours"`
+	HoursBelowTarget int     `json:"hoursBelowTarget"`
+	PercentBelow     float64 `json:"percentBelow"`
+	AverageLux       float64 `json:"averageLux"`
+	MinimumLux       float64 `json:"minimumLux"`
+}
+
+func (c *lightingCompliance) add(lux float64) {
+	if c.OccupiedHours == 0 || lux < c.MinimumLux {
+		c.MinimumLux = lux
+	}
+	c.AverageLux = (c.AverageLux*float64(c.OccupiedHours) + lux) / float64(c.OccupiedHours+1)
+	c.OccupiedHours++
+	if lux < c.TargetLux {
+		c.HoursBelowTarget++
+	}
+	c.PercentBelow = math.Round(float64(c.HoursBelowTarget)/float64(c.OccupiedHours)*10000) / 100
+}
+
+func siteLightingTarget(siteAlias string) (string, float64, bool) {
+	roomType, ok := lightingTargets.Sites[siteAlias]
+	if !ok {
+		return "", 0, false
+	}
+	target, ok := lightingTargets.RoomTypes[roomType]
+	return roomType, target, ok
+}
+
+// lightingReport evaluates the occupied hours of one site in [from, to). When
+// daily is set the result is split per calendar day.
+func lightingReport(ctx context.Context, siteAlias string, from, to time.Time, daily bool) ([]lightingCompliance, error) {
+	roomType, target, ok := siteLightingTarget(siteAlias)
+	if !ok {
+		return nil, fmt.Errorf("lokasi %s tidak memiliki target pencahayaan", siteAlias)
+	}
+
+	averages, err := hourlyAverages(ctx, siteAlias, "light_intensity", from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := make([]time.Time, 0, len(averages))
+	for hour := range averages {
+		if lightingTargets.Occupied.occupied(hour) {
+			hours = append(hours, hour)
+		}
+	}
+	sort.Slice(hours, func(i, j int) bool { return hours[i].Before(hours[j]) })
+
+	summary := lightingCompliance{Site: siteAlias, RoomType: roomType, TargetLux: target}
+	if !daily {
+		for _, hour := range hours {
+			summary.add(averages[hour])
+		}
+		return []lightingCompliance{summary}, nil
+	}
+
+	days := []lightingCompliance{}
+	for _, hour := range hours {
+		date := hour.Format("2006-01-02")
+		if len(days) == 0 || days[len(days)-1].Date != date {
+			day := summary
+			day.Date = date
+			days = append(days, day)
+		}
+		days[len(days)-1].add(averages[hour])
+	}
+	return days, nil
+}
+
+func getLightingCompliance(w http.ResponseWriter, r *http.Request) {
+	siteAlias := mux.Vars(r)["siteAlias"]
+
+	from, to, err := parseTimeRange(r, 7*24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sites := []string{siteAlias}
+	if siteAlias == "" {
+		sites = sites[:0]
+		for site := range lightingTargets.Sites {
+			sites = append(sites, site)
+		}
+		sort.Strings(sites)
+	} else if _, _, ok := siteLightingTarget(siteAlias); !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Lokasi %s tidak memiliki target pencahayaan", siteAlias))
+		return
+	}
+
+	results := []lightingCompliance{}
+	for _, site := range sites {
+		report, err := lightingReport(r.Context(), site, from, to, false)
+		if err != nil {
+			log.Printf("Error computing lighting compliance for %s: %v", site, err)
+			writeError(w, http.StatusInternalServerError, "Gagal menghitung kepatuhan pencahayaan")
+			return
+		}
+		results = append(results, report...)
+	}
+
+	if siteAlias != "" {
+		writeJSON(w, http.StatusOK, results[0])
+		return
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+func getLightingDaily(w http.ResponseWriter, r *http.Request) {
+	siteAlias := mux.Vars(r)["siteAlias"]
+
+	if _, _, ok := siteLightingTarget(siteAlias); !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Lokasi %s tidak memiliki target pencahayaan", siteAlias))
+		return
+	}
+
+	from, to, err := parseTimeRange(r, 7*24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	days, err := lightingReport(r.Context(), siteAlias, from, to, true)
+	if err != nil {
+		log.Printf("Error computing daily lighting compliance for %s: %v", siteAlias, err)
+		writeError(w, http.StatusInternalServerError, "Gagal menghitung kepatuhan pencahayaan")
+		return
+	}
+	writeJSON(w, http.StatusOK, days)
+}
@@ -1,44 +1,57 @@
 package main
 
 import (
+	"context"
 	"database/sql"
-	"encoding/csv"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
-	"path/filepath"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"platform"
 )
 
 var db *sql.DB
 var mqttClient mqtt.Client
 
-const (
-	localDSN = "root:xxx@tcp(database:3306)/xxx?parseTime=true"
-)
+// allowedOrigins are the dashboards allowed by CORS and the alert WebSocket.
+var allowedOrigins []string
 
-func initDB() *sql.DB {
-	localDB, err := sql.Open("mysql", localDSN)
+func initDB(cfg config) *sql.DB {
+	localDB, repositories, err := openRepositories(context.Background(), cfg)
 	if err != nil {
 		log.Fatalf("Gagal koneksi ke database lokal: %v", err)
 	}
-	log.Println("Berhasil koneksi ke basis data")
+	repo = repositories
+	log.Printf("Berhasil koneksi ke basis data (%s)", cfg.Database.Driver)
 	return localDB
 }
 
 /* KODE PROGRAM - INISIASI KONEKSI */
-func initMQTT() {
+func initMQTT(cfg config) {
 	opts := mqtt.NewClientOptions().
-		AddBroker("mqtt://emqx-lb:1883").
-		SetUsername("heb_iot").
-		SetPassword("y4kin94n?")
+		AddBroker(cfg.MQTT.Broker).
+		SetUsername(cfg.MQTT.Username).
+		SetPassword(cfg.MQTT.Password)
+	if cfg.MQTT.ClientID != "" {
+		opts.SetClientID(cfg.MQTT.ClientID)
+	}
 
 	mqttClient = mqtt.NewClient(opts)
 	if token := mqttClient.Connect(); token.Wait() && token.Error() != nil {
@@ -46,450 +59,365 @@ func initMQTT() {
 	}
 	log.Println("Berhasil membuat koneksi ke broker MQTT")
 
-	if token := mqttClient.Subscribe("monitoring/sensor", 0, receivedMessageHandler); token.Wait() && token.Error() != nil {
+	if token := mqttClient.Subscribe("monitoring/sensor", 0, countMessages(receivedMessageHandler)); token.Wait() && token.Error() != nil {
 		log.Fatalf("Error subscribing to MQTT topic: %v", token.Error())
 	} else {
 		log.Println("Berhasil subscribe topik 'monitoring/sensor'")
 	}
 
+	if token := mqttClient.Subscribe(controlAckTopic, 1, countMessages(controlAckHandler)); token.Wait() && token.Error() != nil {
+		log.Fatalf("Error subscribing to MQTT topic: %v", token.Error())
+	} else {
+		log.Printf("Berhasil subscribe topik '%s'", controlAckTopic)
+	}
 }
 
 /* KODE PROGRAM - PENERIMAAN PESAN */
 func receivedMessageHandler(client mqtt.Client, msg mqtt.Message) {
+	pendingInserts.Add(1)
 	go func(m mqtt.Message) {
+		defer pendingInserts.Done()
 		startTime := time.Now()
+		defer func() { ingestDuration.Observe(time.Since(startTime).Seconds()) }()
 
-		location, err := time.LoadLocation("Asia/Jakarta")
-		if err != nil {
-			logToCSV([]string{"Timezone tidak tersedia"})
-			return
-		}
+		ctx, span := tracer.Start(context.Background(), "mqtt process "+m.Topic(),
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				attribute.String("messaging.system", "mqtt"),
+				attribute.String("messaging.destination.name", m.Topic()),
+				attribute.Int("messaging.message.body.size", len(m.Payload())),
+			))
+		defer span.End()
 
-		// Log ke terminal (bukan ke CSV)
-		log.Printf("Pesan diterima. Topik: %s, Payload: %s", m.Topic(), m.Payload())
-
-		logData := []string{}
-		dbInsertErrors := []string{}
+		logger := slog.With("component", "ingest", "topic", m.Topic())
+		logger.Debug("Pesan diterima", "payload", string(m.Payload()))
 
 		// Step 1: Parsing JSON
-		startParsing := time.Now()
 		var payload map[string]float64
 		if err := json.Unmarshal(m.Payload(), &payload); err != nil {
-			logData = append(logData, fmt.Sprintf("Parsing gagal: %v", err, time.Since(startParsing).Seconds()))
-			logToCSV(logData)
+			ingestErrors.WithLabelValues("parse").Inc()
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "payload tidak valid")
+			logger.Warn("Parsing payload gagal", "error", err, "durationMs", platform.ElapsedMs(startTime))
 			return
 		}
-		durationParsing := time.Since(startParsing)
-		logData = append(logData, fmt.Sprintf("Parsing berhasil: %.10f", durationParsing.Seconds()))
 
 		// Step 2: Insert per item
+		var failed []string
 		for deviceId, value := range payload {
 			startInsert := time.Now()
-			currentTime := startInsert.In(location).Format("2006-01-02 15:04:05.000")
-			query := "INSERT INTO `Value` (deviceId, value, created) VALUES (?, ?, ?)"
-			_, err := db.Exec(query, deviceId, value, currentTime)
-			durationInsert := time.Since(startInsert)
-
-			if err != nil {
-				dbInsertErrors = append(dbInsertErrors, deviceId)
-				logData = append(logData, fmt.Sprintf("DB Insert Gagal: %s error: %v (%v detik)", deviceId, err, durationInsert.Seconds()))
-			} else {
-				logData = append(logData, fmt.Sprintf("%s %.10f %v", deviceId, value, durationInsert.Seconds()))
+			if err := repo.Values.Insert(ctx, deviceId, value, startInsert); err != nil {
+				ingestErrors.WithLabelValues("insert").Inc()
+				failed = append(failed, deviceId)
+				logger.Error("DB insert gagal", "deviceId", deviceId, "error", err, "durationMs", platform.ElapsedMs(startInsert))
+				continue
 			}
+			alertEngine.evaluate(deviceId, value, startInsert.In(indonesiaLocation))
 		}
 
 		// Step 3: Evaluasi hasil insert
-		if len(dbInsertErrors) > 0 {
-			logData = append(logData, fmt.Sprintf("Sensor gagal input: %v", dbInsertErrors))
-		} else {
-			logData = append(logData, "Semua data berhasil disimpan ke DB")
+		span.SetAttributes(attribute.Int("ingest.readings", len(payload)), attribute.Int("ingest.failed", len(failed)))
+		if len(failed) > 0 {
+			span.SetStatus(codes.Error, "sebagian data gagal disimpan")
+			logger.Warn("Sebagian data sensor gagal disimpan", "readings", len(payload), "failed", failed, "durationMs", platform.ElapsedMs(startTime))
+			return
 		}
-
-		// Step 4: Total waktu eksekusi
-		totalDuration := time.Since(startTime)
-		logData = append(logData, fmt.Sprintf("Proses selesai: %.10f", totalDuration.Seconds()))
-
-		// Step 5: Simpan ke CSV
-		logToCSV(logData)
+		logger.Info("Data sensor disimpan", "readings", len(payload), "durationMs", platform.ElapsedMs(startTime))
 	}(msg)
 }
 
-var maxPayloadSize int
-
-func logToCSV(data []string) {
-	location, _ := time.LoadLocation("Asia/Jakarta")
-	timestamp := time.Now().In(location).Format("02/01/06 15:04:05.000")
-
-	filePath := "/home/sstk/HEB2024/dashboard-bms/be-1/log-insert/log.csv"
-	dir := filepath.Dir(filePath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		log.Printf("Gagal membuat direktori: %v", err)
-		return
-	}
-
-	fileExists := true
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		fileExists = false
-	}
-
-	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		log.Printf("Gagal membuka file CSV: %v", err)
-		return
-	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
-
-	if len(data) > maxPayloadSize {
-		maxPayloadSize = len(data)
-	}
-
-	if !fileExists {
-		header := []string{"Timestamp", "Parsing Time"}
-		for i := 1; i <= maxPayloadSize-3; i++ {
-			header = append(header, fmt.Sprintf("Insert %d", i))
-		}
-		header = append(header, "Insert Status", "Total Execution Time")
-		writer.Write(header)
-	}
+/* KODE PROGRAM - SEMUA PARAMETER */
 
-	for len(data) < maxPayloadSize {
-		data = append(data, "")
-	}
+// staleAfter is the age after which a parameter's latest reading is flagged
+// as stale in /api/monitoring responses.
+var staleAfter = 15 * time.Minute
 
-	record := append([]string{timestamp}, data...)
-	if err := writer.Write(record); err != nil {
-		log.Printf("Gagal menulis log ke file CSV: %v", err)
-	} else {
-		log.Printf("Berhasil menulis log ke file CSV: %v", record)
-	}
+type parameterReading struct {
+	Value      float64 `json:"value"`
+	Unit       string  `json:"unit"`
+	MeasuredAt string  `json:"measuredAt"`
+	AgeSeconds int64   `json:"ageSeconds"`
+	Stale      bool    `json:"stale"`
 }
 
-/* KODE PROGRAM - SEMUA PARAMETER */
+// parameterHandler returns the latest reading of every parameter in a room.
+// The legacy flat {"alias": value} shape is served with ?format=flat.
 func parameterHandler(w http.ResponseWriter, r *http.Request) {
 	startTime := time.Now()
 
 	vars := mux.Vars(r)
 	roomId := vars["roomId"]
+	logger := slog.With("component", "monitoring", "roomId", roomId)
 
 	if roomId == "" {
 		http.Error(w, `{"error": "roomId required"}`, http.StatusBadRequest)
-		logIEQIndoor("-", []string{"roomId kosong/tidak diberikan"})
+		logger.Warn("roomId kosong/tidak diberikan")
 		return
 	}
 
-	validateStart := time.Now()
-
-	// Step 1: Validasi selesai
-	validateDuration := time.Since(validateStart).Seconds()
-	fmt.Printf("Validasi selesai, durasi: %.10f detik\n", validateDuration)
-
-	query := `
-        SELECT v.value, s.alias AS parameter
-        FROM Value v
-        JOIN Parameter s ON v.deviceId = s.id
-        JOIN Site si ON s.siteId = si.id
-        WHERE si.alias = ?
-        AND v.created = (SELECT MAX(created) FROM Value WHERE deviceId = s.id)
-        ORDER BY s.alias
-    `
+	flat := r.URL.Query().Get("format") == "flat"
 
 	startQuery := time.Now()
-	fmt.Println("Query started")
-	rows, err := db.Query(query, roomId)
+	parameters, err := repo.Parameters.BySite(r.Context(), roomId)
+	var latest map[string]timedValue
+	if err == nil {
+		latest, err = repo.Values.Latest(r.Context(), parameterIds(parameters))
+	}
 	if err != nil {
 		http.Error(w, "Error fetching data from database", http.StatusInternalServerError)
-		log.Println("Error fetching data:", err)
-		logIEQIndoor(roomId, []string{"Error saat query DB"})
+		logger.Error("Error saat query DB", "error", err)
 		return
 	}
-	defer rows.Close()
+	queryMs := platform.ElapsedMs(startQuery)
 
-	// Step 2: Query Parameter berhasil
-	queryDuration := time.Since(startQuery).Seconds()
-	fmt.Printf("Durasi Query DB: %.10f detik\n", queryDuration)
+	flatResult := make(map[string]float64)
+	readings := make(map[string]parameterReading)
 
-	result := make(map[string]float64)
-
-	// Step 3: Map terbentuk
-	mapStart := time.Now()
-	for rows.Next() {
-		var value float64
-		var parameter string
-		if err := rows.Scan(&value, &parameter); err != nil {
-			http.Error(w, "Error scanning database result", http.StatusInternalServerError)
-			log.Println("Error scanning result:", err)
-			logIEQIndoor(roomId, []string{"Error saat membaca hasil rows DB"})
-			return
+	now := time.Now().In(indonesiaLocation)
+	for _, p := range parameters {
+		reading, ok := latest[p.ID]
+		if !ok {
+			continue
 		}
-		result[parameter] = value
+		flatResult[p.Alias] = reading.Value
+
+		age := now.Sub(reading.Time)
+		readings[p.Alias] = parameterReading{
+			Value:      reading.Value,
+			Unit:       p.Unit,
+			MeasuredAt: reading.Time.Format(time.RFC3339),
+			AgeSeconds: int64(age.Seconds()),
+			Stale:      age > staleAfter,
+		}
+	}
+	var result interface{} = readings
+	if flat {
+		result = flatResult
 	}
-	mapDuration := time.Since(mapStart).Seconds()
-	fmt.Printf("Map parameter berhasil terbentuk, durasi: %.10f detik\n", mapDuration)
 
-	// Step 4: Pengiriman JSON
-	jsonStart := time.Now()
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	if err := json.NewEncoder(w).Encode(result); err != nil {
 		http.Error(w, "Failed to encode JSON", http.StatusInternalServerError)
-		log.Println("Failed to encode JSON:", err)
-		logIEQIndoor(roomId, []string{"Gagal encode hasil ke JSON"})
+		logger.Error("Gagal encode hasil ke JSON", "error", err)
 		return
 	}
-	jsonDuration := time.Since(jsonStart).Seconds()
-	fmt.Printf("JSON berhasil dikirim, durasi: %.10f detik\n", jsonDuration)
 
-	// Step 5: Waktu total eksekusi
-	totalDuration := time.Since(startTime).Seconds()
-	fmt.Printf("Total Eksekusi: %.10f detik\n", totalDuration)
-
-	// Simpan ke csv
-	logIEQIndoor(roomId, []string{
-		fmt.Sprintf("Validasi: %.10f", validateDuration), fmt.Sprintf("Query DB: %.10f", queryDuration),
-		fmt.Sprintf("Map: %.10f", mapDuration), fmt.Sprintf("JSON: %.10f", jsonDuration), fmt.Sprintf("Total Eksekusi: %.10f", totalDuration),
-	})
+	logger.Info("Parameter ruangan dikirim", "parameters", len(readings), "queryMs", queryMs, "durationMs", platform.ElapsedMs(startTime))
 }
 
-var logHeaders = []string{
-	"Timestamp",
-	"Site (roomId)",
-	"Step 1 - Validasi SiteID",
-	"Step 2 - Query Parameter",
-	"Step 3 - Map Terbentuk",
-	"Step 4 - JSON Dikirim",
-	"Step 5 - Total Waktu Eksekusi",
-}
-
-func logIEQIndoor(roomId string, data []string) {
-	location, _ := time.LoadLocation("Asia/Jakarta")
-	timestamp := time.Now().In(location).Format("2006-01-02 15:04:05.000")
-
-	filePath := "/home/sstk/HEB2024/dashboard-bms/be-1/log-responseIEQ/log-IEQ.csv"
-	dir := filepath.Dir(filePath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		log.Printf("Gagal membuat direktori: %v", err)
-		return
-	}
-
-	fileExists := true
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		fileExists = false
+/* KODE PROGRAM - GRAFIK HISTORIS */
+func getDeviceIdByAlias(ctx context.Context, siteAlias, aliasDeviceID string) (string, error) {
+	parameter, err := repo.Parameters.Find(ctx, siteAlias, aliasDeviceID)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("Lokasi atau parameter tidak ditemukan %s", aliasDeviceID)
 	}
-
-	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
-		log.Printf("Gagal membuka file CSV: %v", err)
-		return
-	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
-
-	if !fileExists {
-		writer.Write(logHeaders)
-	}
-
-	for len(data) < len(logHeaders)-2 {
-		data = append(data, "")
-	}
-
-	record := append([]string{timestamp, roomId}, data...)
-	if err := writer.Write(record); err != nil {
-		log.Printf("Gagal menulis log ke file CSV: %v", err)
-	} else {
-		log.Printf("Berhasil menulis log ke file CSV: %v", record)
+		return "", fmt.Errorf("Gagal mengambil device %s: %v", aliasDeviceID, err)
 	}
+	return parameter.ID, nil
 }
 
-/* KODE PROGRAM - GRAFIK HISTORIS */
-func getDeviceIdByAlias(siteAlias, aliasDeviceID string, prevTime *time.Time) (string, []string, error) {
-	query :=
-		`	SELECT p.id
-		FROM Parameter p
-		JOIN Site s ON p.siteId = s.id
-		WHERE s.alias = ? AND p.alias = ?`
-
-	var deviceId string
-	startQuery := time.Now()
-	err := db.QueryRow(query, siteAlias, aliasDeviceID).Scan(&deviceId)
-
-	logData := []string{}
-
-	// Step 2 - Query Device ID
+func getLatestData(ctx context.Context, deviceId string) ([]map[string]interface{}, error) {
+	series, err := repo.Values.Recent(ctx, deviceId, 30)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			logData = append(logData, fmt.Sprintf("Error: %v", err))
-			return "", logData, fmt.Errorf("Lokasi atau parameter tidak ditemukan %s", aliasDeviceID)
-		}
-		log.Printf("Error retrieving deviceId from database: %v", err)
-		logData = append(logData, fmt.Sprintf("Query Device ID Gagal: %v", err))
-	} else {
-		queryDuration := time.Since(startQuery)
-		logData = append(logData, fmt.Sprintf("Step 2 - Query Device ID: %.10f", queryDuration.Seconds()))
+		return nil, err
 	}
 
-	return deviceId, logData, nil
-}
-
-func getLatestData(deviceId string, prevTime *time.Time) ([]map[string]interface{}, []string, error) {
-	query := `SELECT v.value, v.created FROM Value v WHERE v.deviceId = ? ORDER BY v.created DESC LIMIT 30;`
-	startQuery := time.Now()
-	rows, err := db.Query(query, deviceId)
-	if err != nil {
-		log.Printf("Error querying data: %v", err)
-		return nil, nil, err
-	}
-	defer rows.Close()
-
-	logData := []string{}
-
-	// Step 3 - Query Data Terakhir
-	queryDuration := time.Since(startQuery)
-	logData = append(logData, fmt.Sprintf("Step 3 - Query Data Terakhir: %.10f", queryDuration.Seconds()))
-
 	var data []map[string]interface{}
-	for rows.Next() {
-		var value float64
-		var created time.Time
-		if err := rows.Scan(&value, &created); err != nil {
-			log.Printf("Error scanning row: %v", err)
-			return nil, logData, err
-		}
-
+	for _, point := range series {
 		data = append(data, map[string]interface{}{
-			"value": value,
-			"date":  created.Format("2006/01/02"),
-			"time":  created.Format("15:04:05"),
+			"value": point.Value,
+			"date":  point.Time.Format("2006/01/02"),
+			"time":  point.Time.Format("15:04:05"),
 		})
 	}
-
-	// Step 5 - Parse Row Into Data
-	addLogStep(&logData, "Step 4 - Pembentukan map:", prevTime)
-
-	return data, logData, nil
+	return data, nil
 }
 
 func getHistory(w http.ResponseWriter, r *http.Request) {
-	startTime := time.Now().In(time.FixedZone("Asia/Jakarta", 7*3600))
-	prevTime := startTime
+	startTime := time.Now()
 
 	vars := mux.Vars(r)
 	siteAlias := vars["siteAlias"]
 	aliasDeviceID := vars["aliasDeviceID"]
+	logger := slog.With("component", "history", "siteAlias", siteAlias, "device", aliasDeviceID)
 
 	if siteAlias == "" || aliasDeviceID == "" {
 		http.Error(w, "Alias Site dan Device belum anda masukkan.", http.StatusBadRequest)
-		logHistory(siteAlias, aliasDeviceID, []string{"Alias kosong di URL"})
+		logger.Warn("Alias kosong di URL")
 		return
 	}
 
-	logData := []string{}
-
-	// Step 1 - Validasi ID
-	addLogStep(&logData, "Step 1 - Validasi Alias:", &prevTime)
+	opts, err := parseHistoryOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		logger.Warn("Opsi rentang tidak valid", "error", err)
+		return
+	}
 
-	deviceId, stepLog, err := getDeviceIdByAlias(siteAlias, aliasDeviceID, &prevTime)
-	logData = append(logData, stepLog...)
+	deviceId, err := getDeviceIdByAlias(r.Context(), siteAlias, aliasDeviceID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
-		logHistory(siteAlias, aliasDeviceID, logData)
+		logger.Warn("Device tidak ditemukan", "error", err)
 		return
 	}
 
-	data, stepLog, err := getLatestData(deviceId, &prevTime)
-	logData = append(logData, stepLog...)
+	var data []map[string]interface{}
+	if opts.Ranged {
+		data, err = getRangeData(r.Context(), deviceId, opts)
+	} else {
+		data, err = getLatestData(r.Context(), deviceId)
+	}
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
-		logHistory(siteAlias, aliasDeviceID, logData)
+		logger.Error("Gagal mengambil data historis", "error", err)
 		return
 	}
 
-	// Step 4 - Response JSON dikirim = Step getLatestData
-	addLogStep(&logData, "Step 5 - Response JSON dikirim:", &prevTime)
-
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(data); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 
-	logData = append(logData, fmt.Sprintf("Total: %.10f", prevTime.Sub(startTime).Seconds()))
-
-	logHistory(siteAlias, aliasDeviceID, logData)
+	logger.Info("Data historis dikirim", "points", len(data), "ranged", opts.Ranged, "durationMs", platform.ElapsedMs(startTime))
 }
 
-var logHeadersHistory = []string{
-	"Timestamp",
-	"Site (roomId)",
-	"Alias Device",
-	"Step 1 - Validasi Alias",
-	"Step 2 - Query Device ID",
-	"Step 3 - Query Latest Data",
-	"Step 4 - Map Terbentuk",
-	"Step 5 - Response JSON",
-}
-
-func logHistory(siteAlias string, aliasDeviceID string, data []string) {
-	location, _ := time.LoadLocation("Asia/Jakarta")
-	timestamp := time.Now().In(location).Format("2006-01-02 15:04:05.000")
-
-	filePath := "/home/sstk/HEB2024/dashboard-bms/be-1/log-resp-history/log-resp-history.csv"
-	dir := filepath.Dir(filePath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		log.Printf("Gagal membuat direktori: %v", err)
+func main() {
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "berkas konfigurasi YAML (opsional)")
+	healthcheck := flag.String("healthcheck", "", "periksa URL kesehatan (misal http://127.0.0.1:10004/readyz) lalu keluar")
+	migrateOnly := flag.Bool("migrate", false, "terapkan migrasi skema basis data lalu keluar")
+	backfill := flag.Bool("backfill-values", false, "isi Value.numericValue untuk baris lama lalu keluar")
+	backfillTS := flag.Bool("backfill-timescale", false, "salin riwayat Value ke TimescaleDB lalu keluar")
+	var backfillOpts backfillOptions
+	flag.IntVar(&backfillOpts.BatchSize, "backfill-batch", 1000, "jumlah baris per transaksi backfill")
+	flag.DurationVar(&backfillOpts.Pause, "backfill-pause", 200*time.Millisecond, "jeda antar batch backfill")
+	flag.BoolVar(&backfillOpts.DryRun, "backfill-dry-run", false, "laporkan baris yang bukan angka tanpa mengubah data")
+	flag.Parse()
+
+	if *healthcheck != "" {
+		if err := probe(*healthcheck); err != nil {
+			log.Fatal(err)
+		}
 		return
 	}
 
-	fileExists := true
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		fileExists = false
-	}
-
-	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	cfg, err := loadConfig(*configFile)
 	if err != nil {
-		log.Printf("Gagal membuka file CSV: %v", err)
+		log.Fatalf("Konfigurasi tidak valid:\n%v", err)
+	}
+	if err := initLogging(cfg); err != nil {
+		log.Fatalf("Gagal menyiapkan log: %v", err)
+	}
+	if *migrateOnly {
+		cfg.Database.Migrate = true
+		conn, _, err := openRepositories(context.Background(), cfg)
+		if err != nil {
+			log.Fatalf("Migrasi gagal: %v", err)
+		}
+		conn.Close()
+		if timeseriesDB != nil {
+			timeseriesDB.Close()
+		}
 		return
 	}
-	defer file.Close()
+	if *backfill {
+		if backfillOpts.BatchSize <= 0 {
+			log.Fatal("-backfill-batch harus lebih dari 0")
+		}
+		conn, _, err := openRepositories(context.Background(), cfg)
+		if err != nil {
+			log.Fatalf("Gagal koneksi ke basis data: %v", err)
+		}
+		report, err := backfillValues(context.Background(), conn, backfillOpts)
+		conn.Close()
+		if err != nil {
+			log.Fatalf("Backfill gagal: %v", err)
+		}
+		log.Printf("Backfill selesai: %d dikonversi, %d bukan angka", report.Converted, report.Unparseable)
+		return
+	}
+	if *backfillTS {
+		if cfg.Timeseries.Driver != "timescale" {
+			log.Fatal("-backfill-timescale butuh timeseries.driver: timescale")
+		}
+		if backfillOpts.BatchSize <= 0 {
+			log.Fatal("-backfill-batch harus lebih dari 0")
+		}
+		conn, _, err := openRepositories(context.Background(), cfg)
+		if err != nil {
+			log.Fatalf("Gagal koneksi ke basis data: %v", err)
+		}
+		copied, err := backfillTimescale(context.Background(), conn, timeseriesDB, backfillOpts)
+		conn.Close()
+		timeseriesDB.Close()
+		if err != nil {
+			log.Fatalf("Backfill TimescaleDB gagal: %v", err)
+		}
+		log.Printf("Backfill TimescaleDB selesai: %d baris disalin", copied)
+		return
+	}
+	allowedOrigins = cfg.HTTP.AllowedOrigins
+	escalateAfter = cfg.Alerts.EscalateAfter.Duration
+	controlAckTimeout = cfg.Control.AckTimeout.Duration
+	staleAfter = cfg.Monitoring.StaleAfter.Duration
+	accuracyTolerance = cfg.SoftSensor.Tolerance.Duration
+	scheduleLocation, _ = time.LoadLocation(cfg.Control.Timezone)
+	jwtSecret = []byte(cfg.Auth.JWTSecret)
+	if len(jwtSecret) == 0 {
+		log.Println("JWT_SECRET belum diatur, hanya rute publik yang dapat diakses")
+	}
+	if len(cfg.Auth.PublicRoutes) > 0 {
+		publicRoutes, _ = platform.ParsePublicRoutes(strings.Join(cfg.Auth.PublicRoutes, ","))
+	}
+	bootstrapAdmins = parseUserList(strings.Join(cfg.Auth.Admins, ","))
+	trustedProxies, _ = parseTrustedProxies(cfg.HTTP.TrustedProxies)
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+	// Inisialisasi database
+	localDB := initDB(cfg)
 
-	if !fileExists {
-		writer.Write(logHeadersHistory)
+	db = localDB
+	registerDBMetrics(db)
+	if err := initTracing(cfg); err != nil {
+		log.Fatalf("Gagal menyiapkan tracing: %v", err)
 	}
 
-	for len(data) < len(logHeadersHistory)-1 {
-		data = append(data, "")
+	if err := alertEngine.reload(); err != nil {
+		log.Printf("Gagal memuat aturan alert: %v", err)
 	}
-
-	record := append([]string{timestamp, siteAlias, aliasDeviceID}, data...)
-	if err := writer.Write(record); err != nil {
-		log.Printf("Gagal menulis log ke file CSV: %v", err)
-	} else {
-		log.Printf("Berhasil menulis log ke file CSV: %v", record)
+	alertEngine.subscribe(alertLifecycle)
+	if path := cfg.Alerts.NotifyChannelsFile; path != "" {
+		if err := loadNotifiers(path); err != nil {
+			log.Fatalf("Gagal memuat kanal notifikasi: %v", err)
+		}
 	}
-}
-
-func addLogStep(logData *[]string, step string, prevTime *time.Time) {
-	stepTime := time.Now()
-	duration := stepTime.Sub(*prevTime).Seconds()
-	*logData = append(*logData, fmt.Sprintf("%s: %.10f", step, duration))
-	*prevTime = stepTime
-}
+	startLoop(runAlertEscalation, time.Minute)
 
-func main() {
-	// Inisialisasi database
-	localDB := initDB()
+	if path := cfg.Control.DemandConfigFile; path != "" {
+		if err := loadDemandConfig(path); err != nil {
+			log.Fatalf("Gagal memuat konfigurasi beban puncak: %v", err)
+		}
+	}
 
-	db = localDB
+	// Kontrol perangkat butuh broker: initMQTT menunggu koneksi sebelum
+	// penjadwal, otomasi dan pengendali beban puncak berjalan.
+	initMQTT(cfg)
+	startLoop(runScheduler, 30*time.Second)
+	startLoop(runAutomations, 30*time.Second)
+	startLoop(runDemandController, 30*time.Second)
 
-	defer localDB.Close()
+	if path := cfg.Monitoring.IAQBreakpointsFile; path != "" {
+		if err := loadIAQScales(path); err != nil {
+			log.Fatalf("Gagal memuat tabel breakpoint IAQ: %v", err)
+		}
+	}
+	if path := cfg.Monitoring.LightingTargetsFile; path != "" {
+		if err := loadLightingConfig(path); err != nil {
+			log.Fatalf("Gagal memuat target pencahayaan: %v", err)
+		}
+	}
 
-	initMQTT()
+	startLoop(runAccuracyJob, time.Hour)
 
 	// Inisialisasi router
 	apiRouter := mux.NewRouter()
@@ -497,10 +425,59 @@ func main() {
 	// Tambahkan rute lainnya
 	apiRouter.HandleFunc("/api/monitoring/{roomId}", parameterHandler).Methods("GET")
 	apiRouter.HandleFunc("/api/grafik/{siteAlias}/{aliasDeviceID}", getHistory).Methods("GET")
+	apiRouter.HandleFunc("/api/grafik-prediksi/{siteAlias}/{parameter}", getPredictHistory).Methods("GET")
+	apiRouter.HandleFunc("/api/iaq", getIAQ).Methods("GET")
+	apiRouter.HandleFunc("/api/iaq/{roomId}", getIAQ).Methods("GET")
+	apiRouter.HandleFunc("/api/iaq/{roomId}/history", getIAQHistory).Methods("GET")
+	apiRouter.HandleFunc("/api/lighting", getLightingCompliance).Methods("GET")
+	apiRouter.HandleFunc("/api/lighting/{siteAlias}", getLightingCompliance).Methods("GET")
+	apiRouter.HandleFunc("/api/lighting/{siteAlias}/daily", getLightingDaily).Methods("GET")
+	apiRouter.HandleFunc("/api/soft-sensor/accuracy", getSoftSensorAccuracy).Methods("GET")
+	apiRouter.HandleFunc("/api/soft-sensor/accuracy/history", getSoftSensorAccuracyHistory).Methods("GET")
+	apiRouter.HandleFunc("/api/alert-rules", getAlertRules).Methods("GET")
+	apiRouter.HandleFunc("/api/alert-rules", createAlertRule).Methods("POST")
+	apiRouter.HandleFunc("/api/alert-rules/{id}", getAlertRule).Methods("GET")
+	apiRouter.HandleFunc("/api/alert-rules/{id}", updateAlertRule).Methods("PUT")
+	apiRouter.HandleFunc("/api/alert-rules/{id}", deleteAlertRule).Methods("DELETE")
+	apiRouter.HandleFunc("/api/alerts", searchAlerts).Methods("GET")
+	apiRouter.HandleFunc("/api/alerts/active", getActiveAlerts).Methods("GET")
+	apiRouter.HandleFunc("/api/alerts/ws", alertFeedHandler).Methods("GET")
+	apiRouter.HandleFunc("/api/alerts/{id}/ack", acknowledgeAlert).Methods("POST")
+	apiRouter.HandleFunc("/api/alerts/{id}/resolve", resolveAlert).Methods("POST")
+	apiRouter.HandleFunc("/api/control/{siteAlias}/{deviceAlias}", controlHandler).Methods("POST")
+	apiRouter.HandleFunc("/api/schedules", getSchedules).Methods("GET")
+	apiRouter.HandleFunc("/api/schedules", createSchedule).Methods("POST")
+	apiRouter.HandleFunc("/api/schedules/{id}", getSchedule).Methods("GET")
+	apiRouter.HandleFunc("/api/schedules/{id}", updateSchedule).Methods("PUT")
+	apiRouter.HandleFunc("/api/schedules/{id}", deleteSchedule).Methods("DELETE")
+	apiRouter.HandleFunc("/api/schedule-overrides", getOverrides).Methods("GET")
+	apiRouter.HandleFunc("/api/schedule-overrides", createOverride).Methods("POST")
+	apiRouter.HandleFunc("/api/schedule-overrides/{id}", deleteOverride).Methods("DELETE")
+	apiRouter.HandleFunc("/api/schedule-jobs", getScheduleJobs).Methods("GET")
+	apiRouter.HandleFunc("/api/automations", getAutomationRules).Methods("GET")
+	apiRouter.HandleFunc("/api/automations", createAutomationRule).Methods("POST")
+	apiRouter.HandleFunc("/api/automations/{id}", getAutomationRule).Methods("GET")
+	apiRouter.HandleFunc("/api/automations/{id}", updateAutomationRule).Methods("PUT")
+	apiRouter.HandleFunc("/api/automations/{id}", deleteAutomationRule).Methods("DELETE")
+	apiRouter.HandleFunc("/api/automations/{id}/evaluate", getAutomationEvaluation).Methods("GET")
+	apiRouter.HandleFunc("/api/automation-log", getAutomationLog).Methods("GET")
+	apiRouter.HandleFunc("/api/demand", getDemandStatus).Methods("GET")
+	apiRouter.HandleFunc("/api/demand/events", getDemandEvents).Methods("GET")
+	apiRouter.HandleFunc("/api/audit", getAuditLog).Methods("GET")
+	apiRouter.HandleFunc("/api/me", getMe).Methods("GET")
+	apiRouter.HandleFunc("/api/admin/roles", getRoles).Methods("GET")
+	apiRouter.HandleFunc("/api/admin/role-assignments", getRoleAssignments).Methods("GET")
+	apiRouter.HandleFunc("/api/admin/role-assignments", createRoleAssignment).Methods("POST")
+	apiRouter.HandleFunc("/api/admin/role-assignments/{id}", deleteRoleAssignment).Methods("DELETE")
+	apiRouter.Use(tracingMiddleware)
+	apiRouter.Use(metricsMiddleware)
+	apiRouter.Use(auditMiddleware)
+	apiRouter.Use(authMiddleware)
+	apiRouter.Use(rbacMiddleware)
 
 	// Middleware CORS
 	corsMiddleware := cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://xxx:10006", "http://xxx:10006", "http://xxx:10006"},
+		AllowedOrigins:   allowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Content-Type", "Authorization", "X-Requested-With"},
 		AllowCredentials: true,
@@ -509,7 +486,30 @@ func main() {
 	// Main multiplexer
 	mainMux := http.NewServeMux()
 	mainMux.Handle("/", corsMiddleware)
+	mainMux.HandleFunc("/healthz", healthHandler)
+	mainMux.HandleFunc("/readyz", readyHandler)
+
+	log.Printf("Server is running on %s...", cfg.HTTP.Addr)
+	server := &http.Server{Addr: cfg.HTTP.Addr, Handler: mainMux}
+	servers := []*http.Server{server}
+	// Metrik hanya disajikan di port terpisah yang tidak dibuka ke luar jaringan internal
+	if cfg.HTTP.MetricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", promhttp.Handler())
+		servers = append(servers, &http.Server{Addr: cfg.HTTP.MetricsAddr, Handler: metricsMux})
+		log.Printf("Metrics are served on %s/metrics", cfg.HTTP.MetricsAddr)
+	}
+	for _, s := range servers {
+		go func(s *http.Server) {
+			if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatal(err)
+			}
+		}(s)
+	}
 
-	log.Println("Server is running on port 10004...")
-	log.Fatal(http.ListenAndServe(":10004", mainMux))
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+	log.Println("Sinyal berhenti diterima, menghentikan server...")
+	shutdown(servers...)
 }
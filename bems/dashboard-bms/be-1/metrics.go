@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"platform"
+)
+
+/* KODE PROGRAM - METRIK PROMETHEUS */
+
+// metricsNamespace prefixes every metric exported by this backend.
+const metricsNamespace = "integrasi"
+
+var (
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of API requests by route template and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	mqttMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "mqtt_messages_total",
+		Help:      "MQTT messages received per topic.",
+	}, []string{"topic"})
+
+	ingestDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "ingest_duration_seconds",
+		Help:      "Time to parse and store one sensor message.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	ingestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "ingest_errors_total",
+		Help:      "Sensor messages that failed to parse and readings that failed to insert.",
+	}, []string{"reason"})
+
+	dbQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Database query time by operation.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation"})
+)
+
+// registerDBMetrics exports the connection pool statistics of db.
+func registerDBMetrics(db *sql.DB) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, metricsNamespace))
+}
+
+// observeDB times a query and traces it as a child span of ctx; call the
+// returned func with the query error when it is done.
+func observeDB(ctx context.Context, operation string) func(error) {
+	_, span := tracer.Start(ctx, "db "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system", "mysql"), attribute.String("db.operation", operation)))
+	start := time.Now()
+	return func(err error) {
+		dbQueryDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		if err == sql.ErrNoRows {
+			err = nil
+		}
+		platform.EndSpan(span, err)
+	}
+}
+
+// execDB runs a statement on db under observeDB.
+func execDB(ctx context.Context, operation, query string, args ...interface{}) (sql.Result, error) {
+	done := observeDB(ctx, operation)
+	res, err := db.ExecContext(ctx, query, args...)
+	done(err)
+	return res, err
+}
+
+// execTx runs a statement inside tx under observeDB.
+func execTx(ctx context.Context, tx *sql.Tx, operation, query string, args ...interface{}) (sql.Result, error) {
+	done := observeDB(ctx, operation)
+	res, err := tx.ExecContext(ctx, query, args...)
+	done(err)
+	return res, err
+}
+
+// queryDB runs a query on db under observeDB. Only the query itself is
+// timed, not reading the rows.
+func queryDB(ctx context.Context, operation, query string, args ...interface{}) (*sql.Rows, error) {
+	done := observeDB(ctx, operation)
+	rows, err := db.QueryContext(ctx, query, args...)
+	done(err)
+	return rows, err
+}
+
+// queryRowDB runs a single-row query on db under observeDB.
+func queryRowDB(ctx context.Context, operation, query string, args ...interface{}) *sql.Row {
+	done := observeDB(ctx, operation)
+	row := db.QueryRowContext(ctx, query, args...)
+	done(row.Err())
+	return row
+}
+
+// countMessages wraps an MQTT handler so every message is counted per topic.
+func countMessages(handler mqtt.MessageHandler) mqtt.MessageHandler {
+	return func(client mqtt.Client, msg mqtt.Message) {
+		mqttMessages.WithLabelValues(msg.Topic()).Inc()
+		handler(client, msg)
+	}
+}
+
+// metricsMiddleware records the latency of every routed request. WebSocket
+// upgrades are skipped: their duration is the life of the connection.
+func metricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := &platform.StatusRecorder{ResponseWriter: w, Status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(recorder, r)
+
+		method, route, _ := strings.Cut(platform.RouteKey(r), " ")
+		httpDuration.WithLabelValues(method, route, strconv.Itoa(recorder.Status)).Observe(time.Since(start).Seconds())
+	})
+}
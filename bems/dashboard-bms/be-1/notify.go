@@ -0,0 +1,424 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+/* KODE PROGRAM - KANAL NOTIFIKASI ALERT */
+
+// notification is what a channel delivers: the alert state change and the
+// alert as it is stored after the change.
+type notification struct {
+	Kind  string `json:"kind"`
+	Alert alert  `json:"alert"`
+}
+
+type notifier interface {
+	Name() string
+	Notify(ctx context.Context, n notification) error
+}
+
+func (n notification) subject() string {
+	return fmt.Sprintf("[BEMS %s] %s %s/%s", strings.ToUpper(n.Alert.Severity), n.Kind, n.Alert.SiteAlias, n.Alert.Parameter)
+}
+
+// smtpNotifier sends plain-text mail. Auth is skipped when Username is empty
+// so it can talk to a local SMTP sink. The whole session is bounded by the
+// context, so a stalled server cannot hold the delivery goroutine.
+type smtpNotifier struct {
+	name     string
+	Addr     string   `json:"addr"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+}
+
+func (s *smtpNotifier) Name() string { return s.name }
+
+func (s *smtpNotifier) Notify(ctx context.Context, n notification) error {
+	host, _, err := net.SplitHostPort(s.Addr)
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "From: %s\r\n", s.From)
+	fmt.Fprintf(&body, "To: %s\r\n", strings.Join(s.To, ", "))
+	fmt.Fprintf(&body, "Subject: %s\r\n", n.subject())
+	fmt.Fprintf(&body, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&body, "%s\r\n\r\nStatus: %s\r\nWaktu: %s\r\nAlert ID: %d\r\n",
+		n.Alert.Message, n.Alert.Status, n.Alert.TriggeredAt.Format("2006-01-02 15:04:05"), n.Alert.ID)
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if s.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.Username, s.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(s.From); err != nil {
+		return err
+	}
+	for _, to := range s.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body.Bytes()); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// webhookNotifier POSTs the notification as JSON. When Secret is set the body
+// is signed with HMAC-SHA256 over "<timestamp>.<body>" and sent in the
+// X-BEMS-Signature header. Failed deliveries are retried, waiting backoff
+// before the first retry and doubling it each time.
+type webhookNotifier struct {
+	name    string
+	URL     string `json:"url"`
+	Secret  string `json:"secret"`
+	Retries int    `json:"retries"`
+	client  *http.Client
+	backoff time.Duration
+}
+
+func (h *webhookNotifier) Name() string { return h.name }
+
+func (h *webhookNotifier) sign(timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(h.Secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (h *webhookNotifier) Notify(ctx context.Context, n notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	backoff := h.backoff
+	for attempt := 0; attempt <= h.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-BEMS-Timestamp", timestamp)
+		if h.Secret != "" {
+			req.Header.Set("X-BEMS-Signature", h.sign(timestamp, body))
+		}
+
+		resp, err := h.client.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 300 {
+			return nil
+		}
+		lastErr = fmt.Errorf("webhook %s membalas %d", h.URL, resp.StatusCode)
+		if resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			return lastErr
+		}
+	}
+	return lastErr
+}
+
+// mqttNotifier publishes the notification JSON on the shared broker
+// connection.
+type mqttNotifier struct {
+	name  string
+	Topic string `json:"topic"`
+	QoS   byte   `json:"qos"`
+}
+
+func (m *mqttNotifier) Name() string { return m.name }
+
+func (m *mqttNotifier) Notify(ctx context.Context, n notification) error {
+	if mqttClient == nil || !mqttClient.IsConnected() {
+		return fmt.Errorf("broker MQTT tidak terhubung")
+	}
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	token := mqttClient.Publish(m.Topic, m.QoS, false, payload)
+	if !token.WaitTimeout(10 * time.Second) {
+		return fmt.Errorf("timeout publish ke %s", m.Topic)
+	}
+	return token.Error()
+}
+
+/* KODE PROGRAM - ROUTING NOTIFIKASI */
+
+// quietHours suppresses notifications below MinSeverity between Start and
+// End ("HH:MM", may wrap past midnight).
+type quietHours struct {
+	Start       string `json:"start"`
+	End         string `json:"end"`
+	MinSeverity string `json:"minSeverity"`
+}
+
+// rateLimit allows at most Max notifications per rule and device within
+// Window; anything beyond is dropped and counted.
+type rateLimit struct {
+	Max    int    `json:"max"`
+	Window string `json:"window"`
+}
+
+type notifyConfig struct {
+	Channels   map[string]json.RawMessage `json:"channels"`
+	Default    []string                   `json:"default"`
+	QuietHours *quietHours                `json:"quietHours"`
+	RateLimit  *rateLimit                 `json:"rateLimit"`
+}
+
+var severityRank = map[string]int{"info": 0, "warning": 1, "critical": 2}
+
+// notifyKinds are the alert changes that reach notification channels.
+// Acknowledgements only go to the live feed.
+var notifyKinds = map[string]bool{alertStatusTriggered: true, "escalated": true, alertStatusResolved: true, alertStatusAutoResolved: true}
+
+type notificationRouter struct {
+	mu         sync.Mutex
+	channels   map[string]notifier
+	defaults   []string
+	quiet      *quietHours
+	maxPerKey  int
+	window     time.Duration
+	sent       map[string][]time.Time
+	suppressed map[string]int
+}
+
+var notifiers = &notificationRouter{
+	channels:   map[string]notifier{},
+	sent:       map[string][]time.Time{},
+	suppressed: map[string]int{},
+}
+
+// loadNotifiers builds the channels described in a JSON file, e.g.
+// {"channels": {"ops": {"type": "smtp", "addr": "localhost:1025", ...}},
+// "default": ["ops"], "quietHours": {...}, "rateLimit": {"max": 5, "window": "1h"}}.
+func loadNotifiers(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var cfg notifyConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("gagal membaca konfigurasi notifikasi %s: %v", path, err)
+	}
+
+	channels := map[string]notifier{}
+	for name, raw := range cfg.Channels {
+		var kind struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(raw, &kind); err != nil {
+			return fmt.Errorf("kanal %s: %v", name, err)
+		}
+
+		var n notifier
+		switch kind.Type {
+		case "smtp":
+			s := &smtpNotifier{name: name}
+			err = json.Unmarshal(raw, s)
+			if err == nil && (s.Addr == "" || s.From == "" || len(s.To) == 0) {
+				err = fmt.Errorf("addr, from dan to wajib diisi")
+			}
+			n = s
+		case "webhook":
+			h := &webhookNotifier{name: name, Retries: 3, client: &http.Client{Timeout: 10 * time.Second}, backoff: time.Second}
+			err = json.Unmarshal(raw, h)
+			if err == nil && h.URL == "" {
+				err = fmt.Errorf("url wajib diisi")
+			}
+			n = h
+		case "mqtt":
+			m := &mqttNotifier{name: name, Topic: "monitoring/alerts", QoS: 1}
+			err = json.Unmarshal(raw, m)
+			n = m
+		default:
+			err = fmt.Errorf("tipe tidak dikenal: %s", kind.Type)
+		}
+		if err != nil {
+			return fmt.Errorf("kanal %s: %v", name, err)
+		}
+		channels[name] = n
+	}
+
+	for _, name := range cfg.Default {
+		if _, ok := channels[name]; !ok {
+			return fmt.Errorf("kanal default tidak dikenal: %s", name)
+		}
+	}
+
+	window := time.Hour
+	maxPerKey := 0
+	if cfg.RateLimit != nil {
+		maxPerKey = cfg.RateLimit.Max
+		if cfg.RateLimit.Window != "" {
+			if window, err = time.ParseDuration(cfg.RateLimit.Window); err != nil {
+				return fmt.Errorf("rateLimit.window tidak valid: %v", err)
+			}
+		}
+	}
+	if q := cfg.QuietHours; q != nil {
+		if _, err := parseClock(q.Start); err != nil {
+			return err
+		}
+		if _, err := parseClock(q.End); err != nil {
+			return err
+		}
+		if _, ok := severityRank[q.MinSeverity]; !ok {
+			return fmt.Errorf("quietHours.minSeverity tidak valid: %s", q.MinSeverity)
+		}
+	}
+
+	notifiers.mu.Lock()
+	defer notifiers.mu.Unlock()
+	notifiers.channels = channels
+	notifiers.defaults = cfg.Default
+	notifiers.quiet = cfg.QuietHours
+	notifiers.maxPerKey = maxPerKey
+	notifiers.window = window
+	log.Printf("Berhasil memuat %d kanal notifikasi dari %s", len(channels), path)
+	return nil
+}
+
+func (q *quietHours) suppresses(severity string, t time.Time) bool {
+	if q == nil || severityRank[severity] >= severityRank[q.MinSeverity] {
+		return false
+	}
+	rule := alertRule{ActiveFrom: q.Start, ActiveTo: q.End}
+	return rule.inWindow(t)
+}
+
+// allow applies the rate limit to one rule/device pair.
+func (n *notificationRouter) allow(key string, now time.Time) bool {
+	if n.maxPerKey <= 0 {
+		return true
+	}
+
+	recent := n.sent[key][:0]
+	for _, t := range n.sent[key] {
+		if now.Sub(t) < n.window {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= n.maxPerKey {
+		n.sent[key] = recent
+		n.suppressed[key]++
+		return false
+	}
+	n.sent[key] = append(recent, now)
+	if dropped := n.suppressed[key]; dropped > 0 {
+		log.Printf("Notifikasi %s: %d pesan ditahan oleh rate limit", key, dropped)
+		delete(n.suppressed, key)
+	}
+	return true
+}
+
+// dispatch delivers an alert change to the channels of its rule in the
+// background. Quiet hours and the rate limit are checked first.
+func (n *notificationRouter) dispatch(kind string, a alert) {
+	if !notifyKinds[kind] {
+		return
+	}
+
+	names := alertEngine.ruleChannels(a.RuleID)
+	now := time.Now().In(indonesiaLocation)
+
+	n.mu.Lock()
+	if len(names) == 0 {
+		names = n.defaults
+	}
+	if len(names) == 0 || n.quiet.suppresses(a.Severity, now) {
+		n.mu.Unlock()
+		return
+	}
+	if !n.allow(fmt.Sprintf("%d/%s", a.RuleID, a.DeviceID), now) {
+		n.mu.Unlock()
+		return
+	}
+	targets := make([]notifier, 0, len(names))
+	for _, name := range names {
+		if channel, ok := n.channels[name]; ok {
+			targets = append(targets, channel)
+		}
+	}
+	n.mu.Unlock()
+
+	message := notification{Kind: kind, Alert: a}
+	for _, target := range targets {
+		pendingNotifications.Add(1)
+		go func(target notifier) {
+			defer pendingNotifications.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+			if err := target.Notify(ctx, message); err != nil {
+				log.Printf("Gagal mengirim notifikasi alert %d lewat %s: %v", a.ID, target.Name(), err)
+			}
+		}(target)
+	}
+}
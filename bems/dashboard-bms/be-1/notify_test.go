@@ -0,0 +1,256 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testNotification() notification {
+	return notification{Kind: alertStatusTriggered, Alert: alert{
+		ID:          7,
+		RuleID:      3,
+		SiteAlias:   "tn_1",
+		Parameter:   "co2",
+		DeviceID:    "device-1",
+		Severity:    "critical",
+		Status:      alertStatusTriggered,
+		Message:     "CO2 di atas ambang",
+		TriggeredAt: time.Date(2024, 5, 1, 10, 0, 0, 0, indonesiaLocation),
+	}}
+}
+
+// smtpSink accepts one SMTP session on a local port and sends the DATA it
+// received on the returned channel.
+func smtpSink(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+		reply("220 localhost sink")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL"), strings.HasPrefix(cmd, "RCPT"):
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 lanjut")
+				var data strings.Builder
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				received <- data.String()
+				reply("250 OK")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("502 tidak didukung")
+			}
+		}
+	}()
+	return ln.Addr().String(), received
+}
+
+func TestSMTPNotifierDeliversToSink(t *testing.T) {
+	addr, received := smtpSink(t)
+	s := &smtpNotifier{name: "ops", Addr: addr, From: "bems@localhost", To: []string{"ops@localhost"}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.Notify(ctx, testNotification()); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	data := <-received
+	for _, want := range []string{"Subject: [BEMS CRITICAL] triggered tn_1/co2", "To: ops@localhost", "CO2 di atas ambang", "Alert ID: 7"} {
+		if !strings.Contains(data, want) {
+			t.Errorf("pesan tidak memuat %q:\n%s", want, data)
+		}
+	}
+}
+
+func TestSMTPNotifierStopsAtDeadline(t *testing.T) {
+	// The server accepts but never greets.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		if conn, err := ln.Accept(); err == nil {
+			defer conn.Close()
+			time.Sleep(5 * time.Second)
+		}
+	}()
+
+	s := &smtpNotifier{name: "ops", Addr: ln.Addr().String(), From: "bems@localhost", To: []string{"ops@localhost"}}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := s.Notify(ctx, testNotification()); err == nil {
+		t.Fatal("Notify berhasil padahal server tidak menjawab")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("Notify baru berhenti setelah %s", elapsed)
+	}
+}
+
+func TestWebhookNotifierSignsAndRetries(t *testing.T) {
+	const secret = "rahasia"
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp := r.Header.Get("X-BEMS-Timestamp")
+		if _, err := strconv.ParseInt(timestamp, 10, 64); err != nil {
+			t.Errorf("X-BEMS-Timestamp tidak valid: %q", timestamp)
+		}
+		want := (&webhookNotifier{Secret: secret}).sign(timestamp, body)
+		if got := r.Header.Get("X-BEMS-Signature"); got != want {
+			t.Errorf("X-BEMS-Signature = %q, seharusnya %q", got, want)
+		}
+		var n notification
+		if err := json.Unmarshal(body, &n); err != nil || n.Alert.ID != 7 {
+			t.Errorf("body tidak valid: %s", body)
+		}
+
+		switch attempts.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	h := &webhookNotifier{name: "hook", URL: server.URL, Secret: secret, Retries: 3, client: server.Client(), backoff: 10 * time.Millisecond}
+	if err := h.Notify(context.Background(), testNotification()); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Fatalf("percobaan = %d, seharusnya 3", got)
+	}
+}
+
+func TestWebhookNotifierGivesUp(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		attempts int32
+	}{
+		{"client error is not retried", http.StatusBadRequest, 1},
+		{"server error is retried until Retries", http.StatusInternalServerError, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			h := &webhookNotifier{name: "hook", URL: server.URL, Retries: 2, client: server.Client(), backoff: time.Millisecond}
+			if err := h.Notify(context.Background(), testNotification()); err == nil {
+				t.Fatal("Notify berhasil padahal server menolak")
+			}
+			if got := attempts.Load(); got != tt.attempts {
+				t.Fatalf("percobaan = %d, seharusnya %d", got, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestQuietHoursSuppresses(t *testing.T) {
+	q := &quietHours{Start: "22:00", End: "06:00", MinSeverity: "critical"}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 5, 1, hour, minute, 0, 0, indonesiaLocation)
+	}
+
+	tests := []struct {
+		severity string
+		t        time.Time
+		want     bool
+	}{
+		{"warning", at(23, 0), true},
+		{"info", at(5, 59), true},
+		{"critical", at(23, 0), false},
+		{"warning", at(6, 0), false},
+		{"warning", at(12, 0), false},
+	}
+	for _, tt := range tests {
+		if got := q.suppresses(tt.severity, tt.t); got != tt.want {
+			t.Errorf("suppresses(%s, %s) = %v, seharusnya %v", tt.severity, tt.t.Format("15:04"), got, tt.want)
+		}
+	}
+
+	var none *quietHours
+	if none.suppresses("info", at(23, 0)) {
+		t.Error("tanpa quietHours tidak ada yang ditahan")
+	}
+}
+
+func TestNotificationRateLimit(t *testing.T) {
+	router := &notificationRouter{
+		maxPerKey:  2,
+		window:     time.Hour,
+		sent:       map[string][]time.Time{},
+		suppressed: map[string]int{},
+	}
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, indonesiaLocation)
+
+	for i, want := range []bool{true, true, false, false} {
+		if got := router.allow("3/device-1", now.Add(time.Duration(i)*time.Minute)); got != want {
+			t.Fatalf("pesan ke-%d: allow = %v, seharusnya %v", i+1, got, want)
+		}
+	}
+	if got := router.suppressed["3/device-1"]; got != 2 {
+		t.Fatalf("suppressed = %d, seharusnya 2", got)
+	}
+	if !router.allow("3/device-2", now) {
+		t.Fatal("perangkat lain tidak boleh ikut dibatasi")
+	}
+	if !router.allow("3/device-1", now.Add(time.Hour)) {
+		t.Fatal("pesan setelah window lewat harus diizinkan")
+	}
+	if _, ok := router.suppressed["3/device-1"]; ok {
+		t.Fatal("hitungan suppressed harus direset setelah pesan terkirim")
+	}
+}
@@ -0,0 +1,360 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"platform"
+)
+
+/* KODE PROGRAM - OTORISASI BERBASIS PERAN */
+
+// routePermissions maps "METHOD /path/template" to the permission it needs;
+// "" only requires a signed-in user. Unlisted GET routes need read, any other
+// unlisted route needs admin.
+var routePermissions = map[string]string{
+	"POST /api/alert-rules":                       platform.PermAlert,
+	"PUT /api/alert-rules/{id}":                   platform.PermAlert,
+	"DELETE /api/alert-rules/{id}":                platform.PermAlert,
+	"POST /api/alerts/{id}/ack":                   platform.PermAlert,
+	"POST /api/alerts/{id}/resolve":               platform.PermAlert,
+	"POST /api/control/{siteAlias}/{deviceAlias}": platform.PermControl,
+	"POST /api/schedules":                         platform.PermControl,
+	"PUT /api/schedules/{id}":                     platform.PermControl,
+	"DELETE /api/schedules/{id}":                  platform.PermControl,
+	"POST /api/schedule-overrides":                platform.PermControl,
+	"DELETE /api/schedule-overrides/{id}":         platform.PermControl,
+	"POST /api/automations":                       platform.PermControl,
+	"PUT /api/automations/{id}":                   platform.PermControl,
+	"DELETE /api/automations/{id}":                platform.PermControl,
+	"GET /api/me":                                 "",
+	"GET /api/audit":                              platform.PermAdmin,
+	"GET /api/admin/roles":                        platform.PermAdmin,
+	"GET /api/admin/role-assignments":             platform.PermAdmin,
+	"POST /api/admin/role-assignments":            platform.PermAdmin,
+	"DELETE /api/admin/role-assignments/{id}":     platform.PermAdmin,
+}
+
+// bootstrapAdmins always hold the admin role on every site, so the first
+// assignments can be made through the API (RBAC_ADMINS).
+var bootstrapAdmins = map[string]bool{}
+
+func loadGrants(ctx context.Context, username string) ([]platform.RoleGrant, error) {
+	grants := []platform.RoleGrant{}
+	if bootstrapAdmins[username] {
+		grants = append(grants, platform.RoleGrant{Username: username, Role: "admin"})
+	}
+
+	rows, err := queryDB(ctx, "user_roles", "SELECT id, username, role, siteAlias, createdBy FROM UserRole WHERE username = ?", username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		g, err := scanGrant(rows)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, g)
+	}
+	return grants, rows.Err()
+}
+
+func scanGrant(row rowScanner) (platform.RoleGrant, error) {
+	var g platform.RoleGrant
+	var siteAlias, createdBy sql.NullString
+	err := row.Scan(&g.ID, &g.Username, &g.Role, &siteAlias, &createdBy)
+	g.SiteAlias = siteAlias.String
+	g.CreatedBy = createdBy.String
+	return g, err
+}
+
+// allowedSite reports whether the request's user holds perm on a site. An
+// empty site asks for the permission on every site.
+func allowedSite(r *http.Request, perm, site string) bool {
+	return platform.GrantsAllowSite(platform.GrantsFrom(r), perm, site)
+}
+
+// siteCondition limits a list query to the sites where the user holds perm.
+// It returns an SQL condition on column and its arguments: always true for an
+// all-sites grant, always false without any grant.
+func siteCondition(r *http.Request, perm, column string) (string, []interface{}) {
+	grants := platform.GrantsFrom(r)
+	var sites []interface{}
+	for _, g := range grants {
+		if !g.Has(perm) {
+			continue
+		}
+		if g.SiteAlias == "" {
+			return "1 = 1", nil
+		}
+		sites = append(sites, g.SiteAlias)
+	}
+	if len(sites) == 0 {
+		return "1 = 0", nil
+	}
+	return column + " IN (?" + strings.Repeat(", ?", len(sites)-1) + ")", sites
+}
+
+// requireSite answers 403 unless the user holds perm on site. Handlers call
+// it when the site comes from the body or a stored record.
+func requireSite(w http.ResponseWriter, r *http.Request, perm, site string) bool {
+	if allowedSite(r, perm, site) {
+		return true
+	}
+	if site == "" {
+		site = "semua lokasi"
+	}
+	writeError(w, http.StatusForbidden, fmt.Sprintf("Tidak memiliki izin %s untuk %s", perm, site))
+	return false
+}
+
+// requireRecordSite applies requireSite to the site of a stored row. A
+// missing row passes so the handler can answer 404 itself.
+func requireRecordSite(w http.ResponseWriter, r *http.Request, perm, table string, id interface{}) bool {
+	var site sql.NullString
+	err := queryRowDB(r.Context(), "record_site", "SELECT siteAlias FROM "+table+" WHERE id = ?", id).Scan(&site)
+	if err == sql.ErrNoRows {
+		return true
+	}
+	if err != nil {
+		log.Printf("Gagal membaca lokasi %s %v: %v", table, id, err)
+		writeError(w, http.StatusInternalServerError, "Gagal memeriksa izin")
+		return false
+	}
+	return requireSite(w, r, perm, siteScope(site.String))
+}
+
+// siteScope maps the alert rule wildcard to the all-sites scope.
+func siteScope(site string) string {
+	if site == ruleWildcard {
+		return ""
+	}
+	return site
+}
+
+func routePermission(r *http.Request) string {
+	key := platform.RouteKey(r)
+	if perm, ok := routePermissions[key]; ok {
+		return perm
+	}
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return platform.PermRead
+	}
+	return platform.PermAdmin
+}
+
+// rbacMiddleware runs after authMiddleware. Public requests without a user
+// pass through; otherwise the route permission must be held on the site in
+// the path ({siteAlias} or {roomId}), or on any site when the path has none.
+// List handlers then narrow their rows with siteCondition and single-record
+// handlers check the stored site with requireRecordSite.
+func rbacMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := platform.IdentityFrom(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		grants, err := loadGrants(r.Context(), id.Subject)
+		if err != nil {
+			log.Printf("Gagal memuat peran %s: %v", id.Subject, err)
+			writeError(w, http.StatusInternalServerError, "Gagal memeriksa izin")
+			return
+		}
+		r = r.WithContext(platform.WithGrants(r.Context(), grants))
+
+		if publicRoutes[platform.RouteKey(r)] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		perm := routePermission(r)
+		if perm == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		vars := mux.Vars(r)
+		site := vars["siteAlias"]
+		if site == "" {
+			site = vars["roomId"]
+		}
+
+		// Izin admin hanya berlaku bila diberikan untuk semua lokasi
+		allowed := platform.AllowedAnySite(grants, perm)
+		if site != "" || perm == platform.PermAdmin {
+			allowed = allowedSite(r, perm, site)
+		}
+		if !allowed {
+			writeError(w, http.StatusForbidden, fmt.Sprintf("Tidak memiliki izin %s", perm))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+/* KODE PROGRAM - API ADMIN PERAN */
+
+type meResponse struct {
+	Username    string               `json:"username"`
+	ExpiresAt   time.Time            `json:"expiresAt"`
+	Roles       []platform.RoleGrant `json:"roles"`
+	Permissions map[string][]string  `json:"permissions"`
+}
+
+// getMe returns the caller's roles and, per site ("*" = every site), the
+// permissions they grant.
+func getMe(w http.ResponseWriter, r *http.Request) {
+	id, ok := platform.IdentityFrom(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, platform.ErrTokenMissing.Error())
+		return
+	}
+	grants := platform.GrantsFrom(r)
+
+	permissions := map[string][]string{}
+	for _, g := range grants {
+		site := g.SiteAlias
+		if site == "" {
+			site = "*"
+		}
+		for _, p := range platform.RolePermissions[g.Role] {
+			if !containsString(permissions[site], p) {
+				permissions[site] = append(permissions[site], p)
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, meResponse{Username: id.Subject, ExpiresAt: id.ExpiresAt, Roles: grants, Permissions: permissions})
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func getRoles(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, platform.RolePermissions)
+}
+
+func getRoleAssignments(w http.ResponseWriter, r *http.Request) {
+	query := "SELECT id, username, role, siteAlias, createdBy FROM UserRole"
+	var args []interface{}
+	if username := r.URL.Query().Get("username"); username != "" {
+		query += " WHERE username = ?"
+		args = append(args, username)
+	}
+
+	rows, err := queryDB(r.Context(), "role_assignments", query+" ORDER BY username, id", args...)
+	if err != nil {
+		log.Printf("Error querying role assignments: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil daftar peran")
+		return
+	}
+	defer rows.Close()
+
+	grants := []platform.RoleGrant{}
+	for rows.Next() {
+		g, err := scanGrant(rows)
+		if err != nil {
+			log.Printf("Error scanning role assignment: %v", err)
+			writeError(w, http.StatusInternalServerError, "Gagal membaca daftar peran")
+			return
+		}
+		grants = append(grants, g)
+	}
+	writeJSON(w, http.StatusOK, grants)
+}
+
+func createRoleAssignment(w http.ResponseWriter, r *http.Request) {
+	var g platform.RoleGrant
+	if err := json.NewDecoder(r.Body).Decode(&g); err != nil {
+		writeError(w, http.StatusBadRequest, "body JSON tidak valid")
+		return
+	}
+	g.Username = strings.TrimSpace(g.Username)
+	if g.Username == "" {
+		writeError(w, http.StatusBadRequest, "username wajib diisi")
+		return
+	}
+	if _, ok := platform.RolePermissions[g.Role]; !ok {
+		roles := make([]string, 0, len(platform.RolePermissions))
+		for role := range platform.RolePermissions {
+			roles = append(roles, role)
+		}
+		sort.Strings(roles)
+		writeError(w, http.StatusBadRequest, "role harus salah satu dari "+strings.Join(roles, ", "))
+		return
+	}
+	if g.SiteAlias != "" {
+		if exists, _ := repo.Sites.Exists(r.Context(), g.SiteAlias); !exists {
+			writeError(w, http.StatusBadRequest, "Lokasi tidak tersedia")
+			return
+		}
+	}
+
+	var duplicate bool
+	if err := queryRowDB(r.Context(), "role_assignment_exists", "SELECT EXISTS(SELECT 1 FROM UserRole WHERE username = ? AND role = ? AND COALESCE(siteAlias, '') = ?)",
+		g.Username, g.Role, g.SiteAlias).Scan(&duplicate); err != nil {
+		log.Printf("Error checking role assignment: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menyimpan peran")
+		return
+	}
+	if duplicate {
+		writeError(w, http.StatusConflict, "Peran sudah diberikan")
+		return
+	}
+
+	g.CreatedBy = ""
+	if id, ok := platform.IdentityFrom(r); ok {
+		g.CreatedBy = id.Subject
+	}
+	res, err := execDB(r.Context(), "role_assignment_insert", "INSERT INTO UserRole (username, role, siteAlias, createdBy, created) VALUES (?, ?, ?, ?, ?)",
+		g.Username, g.Role, nullableString(g.SiteAlias), nullableString(g.CreatedBy),
+		time.Now().In(indonesiaLocation).Format(dbTimeLayout))
+	if err != nil {
+		log.Printf("Error inserting role assignment: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menyimpan peran")
+		return
+	}
+	g.ID, _ = res.LastInsertId()
+	writeJSON(w, http.StatusCreated, g)
+}
+
+func deleteRoleAssignment(w http.ResponseWriter, r *http.Request) {
+	res, err := execDB(r.Context(), "role_assignment_delete", "DELETE FROM UserRole WHERE id = ?", mux.Vars(r)["id"])
+	if err != nil {
+		log.Printf("Error deleting role assignment: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menghapus peran")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		writeError(w, http.StatusNotFound, "Peran tidak ditemukan")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"message": "Sukses"})
+}
+
+// parseUserList splits a comma-separated list of usernames.
+func parseUserList(s string) map[string]bool {
+	users := map[string]bool{}
+	for _, user := range strings.Split(s, ",") {
+		if user = strings.TrimSpace(user); user != "" {
+			users[user] = true
+		}
+	}
+	return users
+}
@@ -0,0 +1,343 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+/* KODE PROGRAM - AKSES DATA */
+
+// parameterRecord is one Parameter row together with the alias of its site.
+type parameterRecord struct {
+	ID        string
+	SiteID    string
+	SiteAlias string
+	Alias     string
+	Unit      string
+}
+
+// placeholders returns n comma-separated SQL parameter markers for IN (...).
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func parameterIds(parameters []parameterRecord) []string {
+	ids := make([]string, len(parameters))
+	for i, p := range parameters {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+// siteRepository reads the Site table.
+type siteRepository interface {
+	// IDByAlias returns sql.ErrNoRows when the site does not exist.
+	IDByAlias(ctx context.Context, alias string) (string, error)
+	Exists(ctx context.Context, alias string) (bool, error)
+}
+
+// parameterRepository reads the Parameter table.
+type parameterRepository interface {
+	// Find returns sql.ErrNoRows when the site or parameter does not exist.
+	Find(ctx context.Context, siteAlias, alias string) (parameterRecord, error)
+	BySite(ctx context.Context, siteAlias string) ([]parameterRecord, error)
+	List(ctx context.Context) ([]parameterRecord, error)
+}
+
+// seriesRepository reads and writes one time-series table (Value, Stat or
+// Predict). Rows are addressed by Parameter id only, so a backend may keep
+// them apart from Site and Parameter. Returned times are Asia/Jakarta.
+type seriesRepository interface {
+	Insert(ctx context.Context, deviceId string, value float64, at time.Time) error
+	// Recent returns the latest limit rows, newest first.
+	Recent(ctx context.Context, deviceId string, limit int) ([]timedValue, error)
+	// Range returns the rows in [from, to), oldest first.
+	Range(ctx context.Context, deviceId string, from, to time.Time) ([]timedValue, error)
+	// Before returns the latest row at or before at, or sql.ErrNoRows.
+	Before(ctx context.Context, deviceId string, at time.Time) (timedValue, error)
+	// Latest returns the newest row of every device that has one.
+	Latest(ctx context.Context, deviceIds []string) (map[string]timedValue, error)
+}
+
+// modelRepository reads the soft-sensor model selections written by be-2.
+type modelRepository interface {
+	Selections(ctx context.Context, siteId, parameter string) ([]modelSelection, error)
+}
+
+// repositories is the data-access layer used by the handlers. The backend is
+// chosen by database.driver.
+type repositories struct {
+	Sites       siteRepository
+	Parameters  parameterRepository
+	Values      seriesRepository
+	Stats       seriesRepository
+	Predictions seriesRepository
+	Models      modelRepository
+}
+
+var repo repositories
+
+// openRepositories connects to the configured database and returns the
+// handle (still used directly by the rules, schedules, audit and RBAC
+// tables) with the repositories built on it, after applying pending schema
+// migrations unless database.migrate is off. With timeseries.driver set to
+// timescale, Values are served from TimescaleDB and written to both.
+func openRepositories(ctx context.Context, cfg config) (*sql.DB, repositories, error) {
+	var conn *sql.DB
+	var err error
+	switch cfg.Database.Driver {
+	case "sqlite":
+		conn, err = openSQLite(cfg.Database.DSN)
+	default:
+		conn, err = sql.Open("mysql", cfg.Database.DSN)
+	}
+	if err != nil {
+		return nil, repositories{}, err
+	}
+	if cfg.Database.Migrate {
+		if err := migrateSchema(ctx, conn, cfg.Database.Driver); err != nil {
+			conn.Close()
+			return nil, repositories{}, err
+		}
+	}
+	repos := newSQLRepositories(conn)
+
+	// Bacaan sensor dapat dibaca dari TimescaleDB, tetapi tetap ditulis juga
+	// ke Value di database utama karena soft-sensor membaca bacaan terbaru
+	// dari sana. Stat dan Predict tetap hanya di database utama karena
+	// soft-sensor membaca Stat dan menulis Predict langsung di sana.
+	if cfg.Timeseries.Driver == "timescale" {
+		ts, err := openTimescale(ctx, cfg.Timeseries.DSN)
+		if err != nil {
+			conn.Close()
+			return nil, repositories{}, err
+		}
+		timeseriesDB = ts
+		repos.Values = mirroredSeries{timescaleSeries{ts, "value", "value", "value_hourly"}, repos.Values}
+	}
+	return conn, repos, nil
+}
+
+// newSQLRepositories implements every repository with plain SQL understood by
+// both MariaDB and SQLite.
+func newSQLRepositories(conn *sql.DB) repositories {
+	return repositories{
+		Sites:       sqlSites{conn},
+		Parameters:  sqlParameters{conn},
+		Values:      sqlSeries{conn, "Value", "value", "numericValue"},
+		Stats:       sqlSeries{conn, "Stat", "stat", ""},
+		Predictions: sqlSeries{conn, "Predict", "prediction", ""},
+		Models:      sqlModels{conn},
+	}
+}
+
+type sqlSites struct{ db *sql.DB }
+
+func (s sqlSites) IDByAlias(ctx context.Context, alias string) (string, error) {
+	var id string
+	done := observeDB(ctx, "site_by_alias")
+	err := s.db.QueryRowContext(ctx, "SELECT id FROM Site WHERE alias = ?", alias).Scan(&id)
+	done(err)
+	return id, err
+}
+
+func (s sqlSites) Exists(ctx context.Context, alias string) (bool, error) {
+	var exists bool
+	done := observeDB(ctx, "site_exists")
+	err := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Site WHERE alias = ?)", alias).Scan(&exists)
+	done(err)
+	return exists, err
+}
+
+type sqlParameters struct{ db *sql.DB }
+
+const parameterColumns = `SELECT p.id, p.siteId, si.alias, p.alias, p.unit
+        FROM Parameter p
+        JOIN Site si ON p.siteId = si.id`
+
+func scanParameter(scanner interface{ Scan(...interface{}) error }) (parameterRecord, error) {
+	var p parameterRecord
+	var siteAlias, unit sql.NullString
+	err := scanner.Scan(&p.ID, &p.SiteID, &siteAlias, &p.Alias, &unit)
+	p.SiteAlias, p.Unit = siteAlias.String, unit.String
+	return p, err
+}
+
+func (s sqlParameters) Find(ctx context.Context, siteAlias, alias string) (parameterRecord, error) {
+	done := observeDB(ctx, "device_by_alias")
+	p, err := scanParameter(s.db.QueryRowContext(ctx, parameterColumns+" WHERE si.alias = ? AND p.alias = ?", siteAlias, alias))
+	done(err)
+	return p, err
+}
+
+func (s sqlParameters) BySite(ctx context.Context, siteAlias string) ([]parameterRecord, error) {
+	return s.query(ctx, "parameters_by_site", parameterColumns+" WHERE si.alias = ? ORDER BY p.alias", siteAlias)
+}
+
+func (s sqlParameters) List(ctx context.Context) ([]parameterRecord, error) {
+	return s.query(ctx, "parameters", parameterColumns)
+}
+
+func (s sqlParameters) query(ctx context.Context, op, query string, args ...interface{}) ([]parameterRecord, error) {
+	done := observeDB(ctx, op)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var parameters []parameterRecord
+	for rows.Next() {
+		p, err := scanParameter(rows)
+		if err != nil {
+			return nil, err
+		}
+		parameters = append(parameters, p)
+	}
+	return parameters, rows.Err()
+}
+
+// sqlSeries stores readings in table, with the reading itself in column.
+// numeric, when set, names a double copy of a varchar column: inserts fill
+// both and reads prefer it, falling back to column for rows not yet
+// backfilled.
+type sqlSeries struct {
+	db      *sql.DB
+	table   string
+	column  string
+	numeric string
+}
+
+// reading is the select expression for the reading of row t.
+func (s sqlSeries) reading() string {
+	if s.numeric == "" {
+		return "t." + s.column
+	}
+	return fmt.Sprintf("COALESCE(t.%s, t.%s)", s.numeric, s.column)
+}
+
+func (s sqlSeries) op(name string) string {
+	return name + "_" + strings.ToLower(s.table)
+}
+
+func (s sqlSeries) Insert(ctx context.Context, deviceId string, value float64, at time.Time) error {
+	created := at.In(indonesiaLocation).Format(dbTimeLayout)
+	query := fmt.Sprintf("INSERT INTO %s (deviceId, %s, created) VALUES (?, ?, ?)", s.table, s.column)
+	args := []interface{}{deviceId, value, created}
+	if s.numeric != "" {
+		query = fmt.Sprintf("INSERT INTO %s (deviceId, %s, %s, created) VALUES (?, ?, ?, ?)", s.table, s.column, s.numeric)
+		args = []interface{}{deviceId, value, value, created}
+	}
+	done := observeDB(ctx, s.op("insert"))
+	_, err := s.db.ExecContext(ctx, query, args...)
+	done(err)
+	return err
+}
+
+func (s sqlSeries) Recent(ctx context.Context, deviceId string, limit int) ([]timedValue, error) {
+	query := fmt.Sprintf("SELECT %s, t.created FROM %s t WHERE t.deviceId = ? ORDER BY t.created DESC LIMIT ?", s.reading(), s.table)
+	return s.query(ctx, s.op("recent"), query, deviceId, limit)
+}
+
+func (s sqlSeries) Range(ctx context.Context, deviceId string, from, to time.Time) ([]timedValue, error) {
+	query := fmt.Sprintf("SELECT %s, t.created FROM %s t WHERE t.deviceId = ? AND t.created >= ? AND t.created < ? ORDER BY t.created", s.reading(), s.table)
+	return s.query(ctx, s.op("range"), query, deviceId, from.Format(dbTimeLayout), to.Format(dbTimeLayout))
+}
+
+func (s sqlSeries) Before(ctx context.Context, deviceId string, at time.Time) (timedValue, error) {
+	query := fmt.Sprintf("SELECT %s, t.created FROM %s t WHERE t.deviceId = ? AND t.created <= ? ORDER BY t.created DESC LIMIT 1", s.reading(), s.table)
+	series, err := s.query(ctx, s.op("before"), query, deviceId, at.Format(dbTimeLayout))
+	if err != nil {
+		return timedValue{}, err
+	}
+	if len(series) == 0 {
+		return timedValue{}, sql.ErrNoRows
+	}
+	return series[0], nil
+}
+
+func (s sqlSeries) Latest(ctx context.Context, deviceIds []string) (map[string]timedValue, error) {
+	latest := map[string]timedValue{}
+	if len(deviceIds) == 0 {
+		return latest, nil
+	}
+	query := fmt.Sprintf(`
+        SELECT t.deviceId, %[1]s, t.created FROM %[2]s t
+        WHERE t.deviceId IN (%[3]s)
+        AND t.created = (SELECT MAX(created) FROM %[2]s WHERE deviceId = t.deviceId)`,
+		s.reading(), s.table, placeholders(len(deviceIds)))
+	args := make([]interface{}, len(deviceIds))
+	for i, id := range deviceIds {
+		args[i] = id
+	}
+
+	done := observeDB(ctx, s.op("latest"))
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceId string
+		var point timedValue
+		if err := rows.Scan(&deviceId, &point.Value, &point.Time); err != nil {
+			return nil, err
+		}
+		point.Time = localTime(point.Time)
+		latest[deviceId] = point
+	}
+	return latest, rows.Err()
+}
+
+func (s sqlSeries) query(ctx context.Context, op, query string, args ...interface{}) ([]timedValue, error) {
+	done := observeDB(ctx, op)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var series []timedValue
+	for rows.Next() {
+		var point timedValue
+		if err := rows.Scan(&point.Value, &point.Time); err != nil {
+			return nil, err
+		}
+		point.Time = localTime(point.Time)
+		series = append(series, point)
+	}
+	return series, rows.Err()
+}
+
+type sqlModels struct{ db *sql.DB }
+
+func (s sqlModels) Selections(ctx context.Context, siteId, parameter string) ([]modelSelection, error) {
+	done := observeDB(ctx, "model_selections")
+	rows, err := s.db.QueryContext(ctx, `
+        SELECT model, selected_at
+        FROM models
+        WHERE siteId = ? AND parameter = ?
+        ORDER BY selected_at`, siteId, parameter)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var timeline []modelSelection
+	for rows.Next() {
+		var selection modelSelection
+		if err := rows.Scan(&selection.Model, &selection.SelectedAt); err != nil {
+			return nil, err
+		}
+		timeline = append(timeline, selection)
+	}
+	return timeline, rows.Err()
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// testRepositories migrates an in-memory SQLite database with one site and
+// two parameters and installs it as db and repo for the test.
+func testRepositories(t *testing.T) {
+	t.Helper()
+	conn, err := openSQLite("file::memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Setiap koneksi :memory: adalah basis data terpisah
+	conn.SetMaxOpenConns(1)
+	t.Cleanup(func() { conn.Close() })
+
+	if err := migrateSchema(context.Background(), conn, "sqlite"); err != nil {
+		t.Fatalf("migrateSchema: %v", err)
+	}
+	for _, statement := range []string{
+		"INSERT INTO Site (id, name, alias) VALUES ('site-1', 'Ruang Rapat', 'tn_1')",
+		"INSERT INTO Parameter (id, siteId, name, unit, alias) VALUES ('device-co2', 'site-1', 'CO2', 'ppm', 'co2')",
+		"INSERT INTO Parameter (id, siteId, name, unit, alias) VALUES ('device-temp', 'site-1', 'Suhu', 'C', 'temperature')",
+	} {
+		if _, err := conn.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldDB, oldRepo := db, repo
+	db, repo = conn, newSQLRepositories(conn)
+	t.Cleanup(func() { db, repo = oldDB, oldRepo })
+}
+
+func serveRoute(t *testing.T, template string, handler http.HandlerFunc, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	router := mux.NewRouter()
+	router.HandleFunc(template, handler).Methods("GET")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest("GET", target, nil))
+	return recorder
+}
+
+func TestParameterHandlerReturnsLatestReadings(t *testing.T) {
+	testRepositories(t)
+	ctx := context.Background()
+	now := time.Now().In(indonesiaLocation).Truncate(time.Second)
+
+	readings := []struct {
+		deviceId string
+		value    float64
+		at       time.Time
+	}{
+		{"device-co2", 600, now.Add(-2 * time.Minute)},
+		{"device-co2", 850, now.Add(-time.Minute)},
+		{"device-temp", 24.5, now.Add(-2 * time.Hour)},
+	}
+	for _, reading := range readings {
+		if err := repo.Values.Insert(ctx, reading.deviceId, reading.value, reading.at); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	recorder := serveRoute(t, "/api/monitoring/{roomId}", parameterHandler, "/api/monitoring/tn_1")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", recorder.Code, recorder.Body)
+	}
+	var got map[string]parameterReading
+	if err := json.Unmarshal(recorder.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if co2 := got["co2"]; co2.Value != 850 || co2.Unit != "ppm" || co2.Stale {
+		t.Errorf("co2 = %+v, seharusnya bacaan terbaru 850 ppm yang belum basi", co2)
+	}
+	if temp := got["temperature"]; temp.Value != 24.5 || !temp.Stale {
+		t.Errorf("temperature = %+v, seharusnya 24.5 dan basi", temp)
+	}
+
+	recorder = serveRoute(t, "/api/monitoring/{roomId}", parameterHandler, "/api/monitoring/tn_1?format=flat")
+	var flat map[string]float64
+	if err := json.Unmarshal(recorder.Body.Bytes(), &flat); err != nil {
+		t.Fatal(err)
+	}
+	if len(flat) != 2 || flat["co2"] != 850 || flat["temperature"] != 24.5 {
+		t.Errorf("format=flat = %v", flat)
+	}
+}
+
+func TestGetHistoryRange(t *testing.T) {
+	testRepositories(t)
+	ctx := context.Background()
+	start := time.Date(2024, 5, 1, 8, 0, 0, 0, indonesiaLocation)
+	for i := 0; i < 6; i++ {
+		if err := repo.Values.Insert(ctx, "device-co2", float64(400+i*10), start.Add(time.Duration(i)*10*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	recorder := serveRoute(t, "/api/grafik/{siteAlias}/{aliasDeviceID}", getHistory,
+		"/api/grafik/tn_1/co2?from=2024-05-01T08:00:00%2B07:00&to=2024-05-01T08:30:00%2B07:00")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", recorder.Code, recorder.Body)
+	}
+	var got []map[string]interface{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("jumlah titik = %d, seharusnya 3: %v", len(got), got)
+	}
+	if got[0]["value"] != 400.0 || got[0]["time"] != "08:00:00" || got[2]["value"] != 420.0 {
+		t.Errorf("titik = %v", got)
+	}
+
+	recorder = serveRoute(t, "/api/grafik/{siteAlias}/{aliasDeviceID}", getHistory, "/api/grafik/tn_1/humidity")
+	if recorder.Code == http.StatusOK {
+		t.Error("parameter yang tidak ada seharusnya ditolak")
+	}
+}
+
+func TestValuesBeforeKeepsTimestampsWhole(t *testing.T) {
+	testRepositories(t)
+	ctx := context.Background()
+	start := time.Date(2024, 5, 1, 8, 0, 0, 0, indonesiaLocation)
+	for _, at := range []time.Time{start, start, start.Add(time.Minute), start.Add(time.Minute), start.Add(2 * time.Minute)} {
+		if err := repo.Values.Insert(ctx, "device-co2", 500, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	batch, err := valuesBefore(ctx, db, start.Add(time.Hour), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batch) != 1 || !batch[0].Time.Equal(start.Add(2*time.Minute)) {
+		t.Fatalf("batch = %v, seharusnya hanya baris 08:02 tanpa memotong 08:01", batch)
+	}
+
+	batch, err = valuesBefore(ctx, db, batch[0].Time, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batch) != 2 || !batch[1].Time.Equal(start.Add(time.Minute)) {
+		t.Fatalf("batch = %v, seharusnya kedua baris 08:01", batch)
+	}
+}
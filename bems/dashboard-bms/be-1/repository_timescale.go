@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"schema"
+)
+
+/* KODE PROGRAM - BACKEND TIMESCALEDB */
+
+// timescaleSchema creates the value hypertable and its hourly continuous
+// aggregate.
+//
+//go:embed schema_timescale.sql
+var timescaleSchema string
+
+// timeseriesDB is the TimescaleDB handle when timeseries.driver is
+// "timescale", closed on shutdown after the main database.
+var timeseriesDB *sql.DB
+
+// openTimescale connects to PostgreSQL with the TimescaleDB extension and
+// applies the schema one statement at a time, since continuous aggregates
+// cannot be created inside the implicit transaction of a multi-statement
+// query.
+func openTimescale(ctx context.Context, dsn string) (*sql.DB, error) {
+	conn, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, err
+	}
+	for _, statement := range schema.SplitStatements(timescaleSchema) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("gagal menerapkan skema TimescaleDB: %v", err)
+		}
+	}
+	return conn, nil
+}
+
+// seriesAggregator is implemented by series backends that can bucket
+// readings themselves instead of returning every raw row.
+type seriesAggregator interface {
+	// Aggregate applies opts.Agg per opts.Interval. ok is false when the
+	// backend cannot serve the request and the caller should use Range.
+	Aggregate(ctx context.Context, deviceId string, opts historyOptions) (series []timedValue, ok bool, err error)
+}
+
+// timescaleSeries stores readings in a hypertable. Timestamps are timestamptz,
+// so unlike the MariaDB tables they are absolute instants. hourly names the
+// continuous aggregate of the table, if any.
+type timescaleSeries struct {
+	db     *sql.DB
+	table  string
+	column string
+	hourly string
+}
+
+func (s timescaleSeries) op(name string) string {
+	return name + "_" + s.table
+}
+
+func (s timescaleSeries) Insert(ctx context.Context, deviceId string, value float64, at time.Time) error {
+	query := fmt.Sprintf("INSERT INTO %s (device_id, created, %s) VALUES ($1, $2, $3::float8)", s.table, s.column)
+	done := observeDB(ctx, s.op("insert"))
+	_, err := s.db.ExecContext(ctx, query, deviceId, at, value)
+	done(err)
+	return err
+}
+
+func (s timescaleSeries) Recent(ctx context.Context, deviceId string, limit int) ([]timedValue, error) {
+	query := fmt.Sprintf("SELECT %s, created FROM %s WHERE device_id = $1 ORDER BY created DESC LIMIT $2", s.column, s.table)
+	return s.query(ctx, s.op("recent"), query, deviceId, limit)
+}
+
+func (s timescaleSeries) Range(ctx context.Context, deviceId string, from, to time.Time) ([]timedValue, error) {
+	query := fmt.Sprintf("SELECT %s, created FROM %s WHERE device_id = $1 AND created >= $2 AND created < $3 ORDER BY created", s.column, s.table)
+	return s.query(ctx, s.op("range"), query, deviceId, from, to)
+}
+
+func (s timescaleSeries) Before(ctx context.Context, deviceId string, at time.Time) (timedValue, error) {
+	query := fmt.Sprintf("SELECT %s, created FROM %s WHERE device_id = $1 AND created <= $2 ORDER BY created DESC LIMIT 1", s.column, s.table)
+	series, err := s.query(ctx, s.op("before"), query, deviceId, at)
+	if err != nil {
+		return timedValue{}, err
+	}
+	if len(series) == 0 {
+		return timedValue{}, sql.ErrNoRows
+	}
+	return series[0], nil
+}
+
+func (s timescaleSeries) Latest(ctx context.Context, deviceIds []string) (map[string]timedValue, error) {
+	latest := map[string]timedValue{}
+	if len(deviceIds) == 0 {
+		return latest, nil
+	}
+	query := fmt.Sprintf(`
+        SELECT DISTINCT ON (device_id) device_id, %s, created
+        FROM %s
+        WHERE device_id = ANY($1)
+        ORDER BY device_id, created DESC`, s.column, s.table)
+
+	done := observeDB(ctx, s.op("latest"))
+	rows, err := s.db.QueryContext(ctx, query, deviceIds)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deviceId string
+		var point timedValue
+		if err := rows.Scan(&deviceId, &point.Value, &point.Time); err != nil {
+			return nil, err
+		}
+		point.Time = point.Time.In(indonesiaLocation)
+		latest[deviceId] = point
+	}
+	return latest, rows.Err()
+}
+
+// Aggregate reads the hourly continuous aggregate for intervals of an hour or
+// more. Each hourly bucket is assigned whole to the interval it starts in, so
+// interval edges are rounded to the hour.
+func (s timescaleSeries) Aggregate(ctx context.Context, deviceId string, opts historyOptions) ([]timedValue, bool, error) {
+	if s.hourly == "" || opts.Agg == "" || opts.Interval < time.Hour {
+		return nil, false, nil
+	}
+	query := fmt.Sprintf(`
+        SELECT bucket, sum, count, min, max, first, last
+        FROM %s
+        WHERE device_id = $1 AND bucket >= $2 AND bucket < $3
+        ORDER BY bucket`, s.hourly)
+
+	done := observeDB(ctx, s.op("aggregate"))
+	rows, err := s.db.QueryContext(ctx, query, deviceId, opts.From.Truncate(time.Hour), opts.To)
+	done(err)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	type bucket struct {
+		index                      int64
+		sum, min, max, first, last float64
+		count                      int64
+	}
+	var buckets []*bucket
+	for rows.Next() {
+		var start time.Time
+		var hour bucket
+		if err := rows.Scan(&start, &hour.sum, &hour.count, &hour.min, &hour.max, &hour.first, &hour.last); err != nil {
+			return nil, false, err
+		}
+		hour.index = int64(start.Sub(opts.From) / opts.Interval)
+		if start.Before(opts.From) {
+			hour.index = 0
+		}
+
+		if n := len(buckets); n > 0 && buckets[n-1].index == hour.index {
+			b := buckets[n-1]
+			b.sum += hour.sum
+			b.count += hour.count
+			b.min = minFloat(b.min, hour.min)
+			b.max = maxFloat(b.max, hour.max)
+			b.last = hour.last
+			continue
+		}
+		buckets = append(buckets, &hour)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	series := make([]timedValue, 0, len(buckets))
+	for _, b := range buckets {
+		value := b.sum / float64(b.count)
+		switch opts.Agg {
+		case "min":
+			value = b.min
+		case "max":
+			value = b.max
+		case "sum":
+			value = b.sum
+		case "first":
+			value = b.first
+		case "last":
+			value = b.last
+		case "count":
+			value = float64(b.count)
+		}
+		start := opts.From.Add(time.Duration(b.index) * opts.Interval).In(indonesiaLocation)
+		series = append(series, timedValue{Time: start, Value: value})
+	}
+	return series, true, nil
+}
+
+func (s timescaleSeries) query(ctx context.Context, op, query string, args ...interface{}) ([]timedValue, error) {
+	done := observeDB(ctx, op)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var series []timedValue
+	for rows.Next() {
+		var point timedValue
+		if err := rows.Scan(&point.Value, &point.Time); err != nil {
+			return nil, err
+		}
+		point.Time = point.Time.In(indonesiaLocation)
+		series = append(series, point)
+	}
+	return series, rows.Err()
+}
+
+// mirroredSeries reads from primary and writes every row to mirror as well,
+// mirror first. Value keeps being written to the main database when
+// TimescaleDB serves it, since the soft-sensor reads it there.
+type mirroredSeries struct {
+	primary seriesRepository
+	mirror  seriesRepository
+}
+
+func (s mirroredSeries) Insert(ctx context.Context, deviceId string, value float64, at time.Time) error {
+	if err := s.mirror.Insert(ctx, deviceId, value, at); err != nil {
+		return err
+	}
+	return s.primary.Insert(ctx, deviceId, value, at)
+}
+
+func (s mirroredSeries) Recent(ctx context.Context, deviceId string, limit int) ([]timedValue, error) {
+	return s.primary.Recent(ctx, deviceId, limit)
+}
+
+func (s mirroredSeries) Range(ctx context.Context, deviceId string, from, to time.Time) ([]timedValue, error) {
+	return s.primary.Range(ctx, deviceId, from, to)
+}
+
+func (s mirroredSeries) Before(ctx context.Context, deviceId string, at time.Time) (timedValue, error) {
+	return s.primary.Before(ctx, deviceId, at)
+}
+
+func (s mirroredSeries) Latest(ctx context.Context, deviceIds []string) (map[string]timedValue, error) {
+	return s.primary.Latest(ctx, deviceIds)
+}
+
+func (s mirroredSeries) Aggregate(ctx context.Context, deviceId string, opts historyOptions) ([]timedValue, bool, error) {
+	if aggregator, ok := s.primary.(seriesAggregator); ok {
+		return aggregator.Aggregate(ctx, deviceId, opts)
+	}
+	return nil, false, nil
+}
+
+func minFloat(a, b float64) float64 {
+	if b < a {
+		return b
+	}
+	return a
+}
+
+func maxFloat(a, b float64) float64 {
+	if b > a {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+/* KODE PROGRAM - UTILITAS REQUEST */
+
+// dbTimeLayout is the layout used for every DATETIME(3) column written by the
+// services. Timestamps are stored as Asia/Jakarta wall-clock time.
+const dbTimeLayout = "2006-01-02 15:04:05.000"
+
+var indonesiaLocation = loadIndonesiaLocation()
+
+func loadIndonesiaLocation() *time.Location {
+	location, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		return time.FixedZone("Asia/Jakarta", 7*3600)
+	}
+	return location
+}
+
+// localTime reinterprets a DATETIME scanned by the MySQL driver, which reports
+// it as UTC, as the Asia/Jakarta wall-clock time it was written in.
+func localTime(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), indonesiaLocation)
+}
+
+var queryTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+func parseQueryTime(s string) (time.Time, error) {
+	for _, layout := range queryTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, indonesiaLocation); err == nil {
+			return t.In(indonesiaLocation), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("format waktu tidak valid: %s", s)
+}
+
+// parseTimeRange reads the optional "from" and "to" query parameters. A
+// missing "to" defaults to now and a missing "from" to defaultSpan before "to".
+func parseTimeRange(r *http.Request, defaultSpan time.Duration) (time.Time, time.Time, error) {
+	to := time.Now().In(indonesiaLocation)
+	if s := r.URL.Query().Get("to"); s != "" {
+		t, err := parseQueryTime(s)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = t
+	}
+
+	from := to.Add(-defaultSpan)
+	if s := r.URL.Query().Get("from"); s != "" {
+		t, err := parseQueryTime(s)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		from = t
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("rentang waktu tidak valid: from harus sebelum to")
+	}
+	return from, to, nil
+}
+
+// parseDuration reads a Go duration (e.g. "15m", "1h") from the named query
+// parameter, falling back to def when it is absent.
+func parseDuration(r *http.Request, name string, def time.Duration) (time.Duration, error) {
+	s := r.URL.Query().Get(name)
+	if s == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s tidak valid: %s", name, s)
+	}
+	return d, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,552 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"platform"
+)
+
+/* KODE PROGRAM - MESIN ATURAN ALERT */
+
+// ruleWildcard matches any site or parameter.
+const ruleWildcard = "*"
+
+// alertRule is a threshold rule evaluated against every ingested reading.
+// MinDuration (seconds) is how long the threshold must stay breached before
+// an alert triggers; Hysteresis is how far the value must recover before it
+// resolves. ActiveFrom/ActiveTo ("HH:MM") restrict evaluation to a daily
+// window, which may wrap past midnight. Channels names the notification
+// channels the rule's alerts are routed to; empty means the default ones.
+type alertRule struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	SiteAlias   string   `json:"siteAlias"`
+	Parameter   string   `json:"parameter"`
+	Operator    string   `json:"operator"`
+	Threshold   float64  `json:"threshold"`
+	Hysteresis  float64  `json:"hysteresis"`
+	MinDuration int      `json:"minDuration"`
+	ActiveFrom  string   `json:"activeFrom"`
+	ActiveTo    string   `json:"activeTo"`
+	Severity    string   `json:"severity"`
+	Channels    []string `json:"channels"`
+	Enabled     bool     `json:"enabled"`
+}
+
+var ruleOperators = map[string]bool{">": true, ">=": true, "<": true, "<=": true}
+var ruleSeverities = map[string]bool{"info": true, "warning": true, "critical": true}
+
+func (r *alertRule) validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("name wajib diisi")
+	}
+	if r.SiteAlias == "" {
+		r.SiteAlias = ruleWildcard
+	}
+	if r.Parameter == "" {
+		r.Parameter = ruleWildcard
+	}
+	if !ruleOperators[r.Operator] {
+		return fmt.Errorf("operator tidak valid: %s", r.Operator)
+	}
+	if r.Hysteresis < 0 || r.MinDuration < 0 {
+		return fmt.Errorf("hysteresis dan minDuration tidak boleh negatif")
+	}
+	if r.Severity == "" {
+		r.Severity = "warning"
+	}
+	if !ruleSeverities[r.Severity] {
+		return fmt.Errorf("severity tidak valid: %s", r.Severity)
+	}
+	if (r.ActiveFrom == "") != (r.ActiveTo == "") {
+		return fmt.Errorf("activeFrom dan activeTo harus diisi bersamaan")
+	}
+	for _, channel := range r.Channels {
+		if _, ok := notifiers.channels[channel]; !ok {
+			return fmt.Errorf("kanal notifikasi tidak dikenal: %s", channel)
+		}
+	}
+	if r.ActiveFrom != "" {
+		if _, err := parseClock(r.ActiveFrom); err != nil {
+			return err
+		}
+		if _, err := parseClock(r.ActiveTo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r alertRule) matches(siteAlias, parameter string) bool {
+	return (r.SiteAlias == ruleWildcard || r.SiteAlias == siteAlias) &&
+		(r.Parameter == ruleWildcard || r.Parameter == parameter)
+}
+
+func (r alertRule) breached(value float64) bool {
+	switch r.Operator {
+	case ">":
+		return value > r.Threshold
+	case ">=":
+		return value >= r.Threshold
+	case "<":
+		return value < r.Threshold
+	case "<=":
+		return value <= r.Threshold
+	}
+	return false
+}
+
+// cleared reports whether value has moved back past the threshold by at
+// least the hysteresis band.
+func (r alertRule) cleared(value float64) bool {
+	switch r.Operator {
+	case ">", ">=":
+		return value <= r.Threshold-r.Hysteresis
+	default:
+		return value >= r.Threshold+r.Hysteresis
+	}
+}
+
+func (r alertRule) inWindow(t time.Time) bool {
+	if r.ActiveFrom == "" {
+		return true
+	}
+	from, _ := parseClock(r.ActiveFrom)
+	to, _ := parseClock(r.ActiveTo)
+	minute := t.Hour()*60 + t.Minute()
+	if from <= to {
+		return minute >= from && minute < to
+	}
+	return minute >= from || minute < to
+}
+
+// alertEvent is produced whenever a rule starts or stops firing for a device.
+type alertEvent struct {
+	Kind      string    `json:"kind"`
+	RuleID    int64     `json:"ruleId"`
+	RuleName  string    `json:"ruleName"`
+	Severity  string    `json:"severity"`
+	SiteAlias string    `json:"siteAlias"`
+	Parameter string    `json:"parameter"`
+	DeviceID  string    `json:"deviceId"`
+	Value     float64   `json:"value"`
+	Threshold float64   `json:"threshold"`
+	Operator  string    `json:"operator"`
+	Time      time.Time `json:"time"`
+}
+
+const (
+	alertTriggered = "triggered"
+	alertCleared   = "cleared"
+)
+
+type parameterInfo struct {
+	SiteAlias string
+	Alias     string
+}
+
+type ruleState struct {
+	pendingSince time.Time
+	active       bool
+}
+
+type ruleStateKey struct {
+	ruleID   int64
+	deviceID string
+}
+
+// ruleEngine holds the enabled rules, the per-device evaluation state and the
+// handlers that receive produced events.
+type ruleEngine struct {
+	mu         sync.Mutex
+	rules      []alertRule
+	states     map[ruleStateKey]*ruleState
+	parameters map[string]parameterInfo
+	loadedAt   time.Time
+	handlers   []func(alertEvent)
+}
+
+var alertEngine = newRuleEngine()
+
+func newRuleEngine() *ruleEngine {
+	return &ruleEngine{
+		states:     map[ruleStateKey]*ruleState{},
+		parameters: map[string]parameterInfo{},
+	}
+}
+
+// subscribe registers a handler for every event the engine produces.
+// Handlers run on the MQTT goroutine and must not block.
+func (e *ruleEngine) subscribe(handler func(alertEvent)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.handlers = append(e.handlers, handler)
+}
+
+// reload reads the enabled rules from the database and drops state belonging
+// to rules that no longer exist. Open alerts mark their rule and device as
+// active, so after a restart a recovering reading still resolves them and a
+// breach does not open a duplicate. Open alerts of rules that were removed or
+// disabled are resolved, since nothing would clear them any more.
+func (e *ruleEngine) reload() error {
+	rules, err := listAlertRules("WHERE enabled = 1 ORDER BY id")
+	if err != nil {
+		return err
+	}
+	open, err := queryAlerts("SELECT "+alertColumns+" FROM Alert WHERE status IN (?, ?)",
+		alertStatusTriggered, alertStatusAcknowledged)
+	if err != nil {
+		return err
+	}
+
+	ids := map[int64]bool{}
+	for _, rule := range rules {
+		ids[rule.ID] = true
+	}
+	var orphaned []alert
+
+	e.mu.Lock()
+	e.rules = rules
+	for key := range e.states {
+		if !ids[key.ruleID] {
+			delete(e.states, key)
+		}
+	}
+	for _, a := range open {
+		if !ids[a.RuleID] {
+			orphaned = append(orphaned, a)
+			continue
+		}
+		key := ruleStateKey{a.RuleID, a.DeviceID}
+		if e.states[key] == nil {
+			e.states[key] = &ruleState{}
+		}
+		e.states[key].active = true
+	}
+	e.mu.Unlock()
+
+	// publishAlert membaca kanal aturan, jadi dipanggil setelah e.mu dilepas
+	now := time.Now()
+	for _, a := range orphaned {
+		closeAlert(a, now)
+	}
+	return nil
+}
+
+// ruleChannels returns the notification channels of an enabled rule.
+func (e *ruleEngine) ruleChannels(ruleID int64) []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, rule := range e.rules {
+		if rule.ID == ruleID {
+			return rule.Channels
+		}
+	}
+	return nil
+}
+
+// lookupParameter maps a Parameter id to its site and alias, refreshing the
+// cache at most once a minute when an unknown id shows up. The refresh query
+// runs without e.mu so other readings are not held up; loadedAt is claimed
+// first so only one goroutine refreshes.
+func (e *ruleEngine) lookupParameter(deviceID string) (parameterInfo, bool) {
+	e.mu.Lock()
+	if info, ok := e.parameters[deviceID]; ok {
+		e.mu.Unlock()
+		return info, true
+	}
+	if time.Since(e.loadedAt) < time.Minute {
+		e.mu.Unlock()
+		return parameterInfo{}, false
+	}
+	e.loadedAt = time.Now()
+	e.mu.Unlock()
+
+	list, err := repo.Parameters.List(context.Background())
+	if err != nil {
+		log.Printf("Gagal memuat daftar parameter untuk alert: %v", err)
+		return parameterInfo{}, false
+	}
+
+	parameters := map[string]parameterInfo{}
+	for _, p := range list {
+		parameters[p.ID] = parameterInfo{SiteAlias: p.SiteAlias, Alias: p.Alias}
+	}
+	e.mu.Lock()
+	e.parameters = parameters
+	e.mu.Unlock()
+
+	info, ok := parameters[deviceID]
+	return info, ok
+}
+
+// evaluate runs every matching rule against one reading.
+func (e *ruleEngine) evaluate(deviceID string, value float64, at time.Time) {
+	info, ok := e.lookupParameter(deviceID)
+	if !ok {
+		return
+	}
+
+	e.mu.Lock()
+
+	var events []alertEvent
+	for _, rule := range e.rules {
+		if !rule.matches(info.SiteAlias, info.Alias) {
+			continue
+		}
+
+		key := ruleStateKey{rule.ID, deviceID}
+		state := e.states[key]
+		if state == nil {
+			state = &ruleState{}
+			e.states[key] = state
+		}
+
+		// breached is checked before cleared: with zero hysteresis an
+		// inclusive rule at exactly the threshold satisfies both.
+		kind := ""
+		inWindow := rule.inWindow(at)
+		switch {
+		case inWindow && rule.breached(value):
+			if state.pendingSince.IsZero() {
+				state.pendingSince = at
+			}
+			if !state.active && at.Sub(state.pendingSince) >= time.Duration(rule.MinDuration)*time.Second {
+				state.active = true
+				kind = alertTriggered
+			}
+		case !inWindow || rule.cleared(value):
+			state.pendingSince = time.Time{}
+			if state.active {
+				state.active = false
+				kind = alertCleared
+			}
+		default:
+			// Di dalam pita histeresis: alert aktif tetap aktif
+			if !state.active {
+				state.pendingSince = time.Time{}
+			}
+		}
+
+		if kind != "" {
+			events = append(events, alertEvent{
+				Kind:      kind,
+				RuleID:    rule.ID,
+				RuleName:  rule.Name,
+				Severity:  rule.Severity,
+				SiteAlias: info.SiteAlias,
+				Parameter: info.Alias,
+				DeviceID:  deviceID,
+				Value:     value,
+				Threshold: rule.Threshold,
+				Operator:  rule.Operator,
+				Time:      at,
+			})
+		}
+	}
+	handlers := e.handlers
+	e.mu.Unlock()
+
+	for _, event := range events {
+		log.Printf("Alert %s: %s (%s/%s = %v %s %v)", event.Kind, event.RuleName, event.SiteAlias, event.Parameter, event.Value, event.Operator, event.Threshold)
+		for _, handler := range handlers {
+			handler(event)
+		}
+	}
+}
+
+/* KODE PROGRAM - CRUD ATURAN ALERT */
+
+const alertRuleColumns = "id, name, siteAlias, parameter, operator, threshold, hysteresis, minDuration, activeFrom, activeTo, severity, channels, enabled"
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAlertRule(row rowScanner) (alertRule, error) {
+	var rule alertRule
+	var activeFrom, activeTo sql.NullString
+	var channels string
+	err := row.Scan(&rule.ID, &rule.Name, &rule.SiteAlias, &rule.Parameter, &rule.Operator, &rule.Threshold,
+		&rule.Hysteresis, &rule.MinDuration, &activeFrom, &activeTo, &rule.Severity, &channels, &rule.Enabled)
+	rule.ActiveFrom = activeFrom.String
+	rule.ActiveTo = activeTo.String
+	rule.Channels = []string{}
+	if channels != "" {
+		rule.Channels = strings.Split(channels, ",")
+	}
+	return rule, err
+}
+
+func listAlertRules(query string, args ...interface{}) ([]alertRule, error) {
+	rows, err := queryDB(context.Background(), "alert_rules", "SELECT "+alertRuleColumns+" FROM AlertRule "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []alertRule{}
+	for rows.Next() {
+		rule, err := scanAlertRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func decodeAlertRule(r *http.Request) (alertRule, error) {
+	rule := alertRule{Enabled: true}
+	if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+		return rule, fmt.Errorf("body JSON tidak valid: %v", err)
+	}
+	return rule, rule.validate()
+}
+
+func reloadAlertRules() {
+	if err := alertEngine.reload(); err != nil {
+		log.Printf("Gagal memuat ulang aturan alert: %v", err)
+	}
+}
+
+// getAlertRules lists the rules of the sites the user may read. A rule for
+// every site ("*") counts as all sites, as in requireRecordSite.
+func getAlertRules(w http.ResponseWriter, r *http.Request) {
+	scope, args := siteCondition(r, platform.PermRead, "siteAlias")
+	rules, err := listAlertRules("WHERE "+scope+" ORDER BY id", args...)
+	if err != nil {
+		log.Printf("Error querying alert rules: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil aturan alert")
+		return
+	}
+	writeJSON(w, http.StatusOK, rules)
+}
+
+func getAlertRule(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !requireRecordSite(w, r, platform.PermRead, "AlertRule", id) {
+		return
+	}
+	rule, err := scanAlertRule(queryRowDB(r.Context(), "alert_rule_by_id", "SELECT "+alertRuleColumns+" FROM AlertRule WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		writeError(w, http.StatusNotFound, "Aturan alert tidak ditemukan")
+		return
+	}
+	if err != nil {
+		log.Printf("Error querying alert rule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil aturan alert")
+		return
+	}
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func createAlertRule(w http.ResponseWriter, r *http.Request) {
+	rule, err := decodeAlertRule(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !requireSite(w, r, platform.PermAlert, siteScope(rule.SiteAlias)) {
+		return
+	}
+
+	now := time.Now().In(indonesiaLocation).Format(dbTimeLayout)
+	res, err := execDB(r.Context(), "alert_rule_insert", `
+        INSERT INTO AlertRule (name, siteAlias, parameter, operator, threshold, hysteresis, minDuration, activeFrom, activeTo, severity, channels, enabled, created, updated)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rule.Name, rule.SiteAlias, rule.Parameter, rule.Operator, rule.Threshold, rule.Hysteresis, rule.MinDuration,
+		nullableString(rule.ActiveFrom), nullableString(rule.ActiveTo), rule.Severity, strings.Join(rule.Channels, ","), rule.Enabled, now, now)
+	if err != nil {
+		log.Printf("Error inserting alert rule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menyimpan aturan alert")
+		return
+	}
+	rule.ID, _ = res.LastInsertId()
+
+	reloadAlertRules()
+	writeJSON(w, http.StatusCreated, rule)
+}
+
+func updateAlertRule(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "id tidak valid")
+		return
+	}
+	rule, err := decodeAlertRule(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	rule.ID = id
+	if !requireSite(w, r, platform.PermAlert, siteScope(rule.SiteAlias)) || !requireRecordSite(w, r, platform.PermAlert, "AlertRule", id) {
+		return
+	}
+
+	res, err := execDB(r.Context(), "alert_rule_update", `
+        UPDATE AlertRule
+        SET name = ?, siteAlias = ?, parameter = ?, operator = ?, threshold = ?, hysteresis = ?, minDuration = ?,
+            activeFrom = ?, activeTo = ?, severity = ?, channels = ?, enabled = ?, updated = ?
+        WHERE id = ?`,
+		rule.Name, rule.SiteAlias, rule.Parameter, rule.Operator, rule.Threshold, rule.Hysteresis, rule.MinDuration,
+		nullableString(rule.ActiveFrom), nullableString(rule.ActiveTo), rule.Severity, strings.Join(rule.Channels, ","), rule.Enabled,
+		time.Now().In(indonesiaLocation).Format(dbTimeLayout), id)
+	if err != nil {
+		log.Printf("Error updating alert rule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal memperbarui aturan alert")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		var exists bool
+		if err := queryRowDB(r.Context(), "alert_rule_exists", "SELECT EXISTS(SELECT 1 FROM AlertRule WHERE id = ?)", id).Scan(&exists); err != nil {
+			log.Printf("Error checking alert rule: %v", err)
+			writeError(w, http.StatusInternalServerError, "Gagal memperbarui aturan alert")
+			return
+		}
+		if !exists {
+			writeError(w, http.StatusNotFound, "Aturan alert tidak ditemukan")
+			return
+		}
+	}
+
+	reloadAlertRules()
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func deleteAlertRule(w http.ResponseWriter, r *http.Request) {
+	if !requireRecordSite(w, r, platform.PermAlert, "AlertRule", mux.Vars(r)["id"]) {
+		return
+	}
+	res, err := execDB(r.Context(), "alert_rule_delete", "DELETE FROM AlertRule WHERE id = ?", mux.Vars(r)["id"])
+	if err != nil {
+		log.Printf("Error deleting alert rule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menghapus aturan alert")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		writeError(w, http.StatusNotFound, "Aturan alert tidak ditemukan")
+		return
+	}
+
+	reloadAlertRules()
+	writeJSON(w, http.StatusOK, map[string]string{"message": "Sukses"})
+}
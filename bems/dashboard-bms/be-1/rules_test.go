@@ -0,0 +1,90 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInclusiveRuleFiresAtThreshold(t *testing.T) {
+	for _, operator := range []string{">=", "<="} {
+		e := newRuleEngine()
+		e.parameters["device-1"] = parameterInfo{SiteAlias: "tn_1", Alias: "co2"}
+		e.loadedAt = time.Now()
+		e.rules = []alertRule{{ID: 1, Name: "co2", SiteAlias: ruleWildcard, Parameter: "co2", Operator: operator, Threshold: 1000}}
+
+		var kinds []string
+		e.subscribe(func(event alertEvent) { kinds = append(kinds, event.Kind) })
+
+		at := time.Date(2024, 5, 1, 10, 0, 0, 0, indonesiaLocation)
+		e.evaluate("device-1", 1000, at)
+		if len(kinds) != 1 || kinds[0] != alertTriggered {
+			t.Fatalf("%s: nilai tepat di ambang menghasilkan %v, seharusnya [%s]", operator, kinds, alertTriggered)
+		}
+
+		clearing := 999.0
+		if operator == "<=" {
+			clearing = 1001
+		}
+		e.evaluate("device-1", clearing, at.Add(time.Minute))
+		if len(kinds) != 2 || kinds[1] != alertCleared {
+			t.Fatalf("%s: nilai di luar ambang menghasilkan %v, seharusnya diakhiri %s", operator, kinds, alertCleared)
+		}
+	}
+}
+
+func TestReloadRestoresOpenAlerts(t *testing.T) {
+	testRepositories(t)
+	at := time.Now().In(indonesiaLocation).Format(dbTimeLayout)
+	for _, statement := range []string{
+		"INSERT INTO AlertRule (id, name, operator, threshold, enabled, created, updated) VALUES (1, 'co2', '>', 1000, 1, '" + at + "', '" + at + "')",
+		"INSERT INTO AlertRule (id, name, operator, threshold, enabled, created, updated) VALUES (2, 'nonaktif', '>', 1000, 0, '" + at + "', '" + at + "')",
+	} {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Aturan 3 sudah dihapus, tetapi alertnya masih terbuka
+	for _, ruleID := range []int{1, 2, 3} {
+		_, err := db.Exec(`INSERT INTO Alert (ruleId, ruleName, siteAlias, parameter, deviceId, severity, status, message, value, threshold, triggeredAt, updated)
+            VALUES (?, 'aturan', 'tn_1', 'co2', 'device-co2', 'warning', ?, '', 1200, 1000, ?, ?)`, ruleID, alertStatusTriggered, at, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	e := newRuleEngine()
+	e.parameters["device-co2"] = parameterInfo{SiteAlias: "tn_1", Alias: "co2"}
+	e.loadedAt = time.Now()
+	if err := e.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if state := e.states[ruleStateKey{1, "device-co2"}]; state == nil || !state.active {
+		t.Fatal("alert terbuka seharusnya memulihkan status aktif aturan 1")
+	}
+
+	statuses := map[int64]string{}
+	rows, err := db.Query("SELECT ruleId, status FROM Alert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+		var ruleID int64
+		var status string
+		if err := rows.Scan(&ruleID, &status); err != nil {
+			t.Fatal(err)
+		}
+		statuses[ruleID] = status
+	}
+	rows.Close()
+	if statuses[1] != alertStatusTriggered || statuses[2] != alertStatusAutoResolved || statuses[3] != alertStatusAutoResolved {
+		t.Fatalf("status alert = %v; hanya alert aturan 1 yang tetap terbuka", statuses)
+	}
+
+	var kinds []string
+	e.subscribe(func(event alertEvent) { kinds = append(kinds, event.Kind) })
+	e.evaluate("device-co2", 1200, time.Now())
+	e.evaluate("device-co2", 800, time.Now())
+	if len(kinds) != 1 || kinds[0] != alertCleared {
+		t.Fatalf("events = %v, seharusnya hanya cleared tanpa trigger ulang", kinds)
+	}
+}
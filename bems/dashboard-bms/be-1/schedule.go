@@ -0,0 +1,747 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"platform"
+)
+
+/* KODE PROGRAM - PENJADWALAN SAKLAR */
+
+// scheduleLocation is the building timezone weekly schedules are evaluated
+// in. Stored timestamps stay in Asia/Jakarta like the rest of the database.
+var scheduleLocation = indonesiaLocation
+
+// Jobs are planned scheduleHorizon ahead. After a restart, overdue jobs up to
+// catchUpMaxAge old are considered: per device only the latest one runs and
+// the rest are marked skipped; anything older is marked missed.
+var (
+	scheduleHorizon = 24 * time.Hour
+	catchUpMaxAge   = 12 * time.Hour
+)
+
+const (
+	jobPending = "pending"
+	jobDone    = "done"
+	jobFailed  = "failed"
+	jobSkipped = "skipped"
+	jobMissed  = "missed"
+)
+
+// weeklySchedule switches a device at Time ("HH:MM") on the given Days
+// (time.Weekday numbering, 0 = Minggu).
+type weeklySchedule struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	SiteAlias   string   `json:"siteAlias"`
+	DeviceAlias string   `json:"deviceAlias"`
+	Days        []int    `json:"days"`
+	Time        string   `json:"time"`
+	State       string   `json:"state"`
+	Setpoint    *float64 `json:"setpoint"`
+	Enabled     bool     `json:"enabled"`
+}
+
+// scheduleOverride is a one-off command at RunAt. Until, when set, also
+// suppresses the device's weekly jobs due in [RunAt, Until).
+type scheduleOverride struct {
+	ID          int64      `json:"id"`
+	SiteAlias   string     `json:"siteAlias"`
+	DeviceAlias string     `json:"deviceAlias"`
+	RunAt       time.Time  `json:"runAt"`
+	Until       *time.Time `json:"until"`
+	State       string     `json:"state"`
+	Setpoint    *float64   `json:"setpoint"`
+	Note        string     `json:"note"`
+}
+
+type scheduleJob struct {
+	ID            int64      `json:"id"`
+	ScheduleID    *int64     `json:"scheduleId"`
+	OverrideID    *int64     `json:"overrideId"`
+	SiteAlias     string     `json:"siteAlias"`
+	DeviceAlias   string     `json:"deviceAlias"`
+	State         string     `json:"state"`
+	Setpoint      *float64   `json:"setpoint"`
+	DueAt         time.Time  `json:"dueAt"`
+	Status        string     `json:"status"`
+	ExecutedAt    *time.Time `json:"executedAt"`
+	CorrelationID string     `json:"correlationId"`
+	Message       string     `json:"message"`
+}
+
+func validateSwitchTarget(siteAlias, deviceAlias, state string, setpoint *float64) error {
+	if siteAlias == "" || deviceAlias == "" {
+		return fmt.Errorf("siteAlias dan deviceAlias wajib diisi")
+	}
+	if !controllableDevices[deviceAlias] {
+		return fmt.Errorf("perangkat %s tidak dapat dikontrol", deviceAlias)
+	}
+	return controlRequest{State: state, Setpoint: setpoint}.validate()
+}
+
+func (s *weeklySchedule) validate() error {
+	if err := validateSwitchTarget(s.SiteAlias, s.DeviceAlias, s.State, s.Setpoint); err != nil {
+		return err
+	}
+	if len(s.Days) == 0 {
+		return fmt.Errorf("days wajib diisi")
+	}
+	for _, day := range s.Days {
+		if day < 0 || day > 6 {
+			return fmt.Errorf("hari tidak valid: %d", day)
+		}
+	}
+	_, err := parseClock(s.Time)
+	return err
+}
+
+func joinDays(days []int) string {
+	parts := make([]string, len(days))
+	for i, day := range days {
+		parts[i] = strconv.Itoa(day)
+	}
+	return strings.Join(parts, ",")
+}
+
+func splitDays(s string) []int {
+	days := []int{}
+	for _, part := range strings.Split(s, ",") {
+		if day, err := strconv.Atoi(strings.TrimSpace(part)); err == nil {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+func nullableFloat(f sql.NullFloat64) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+func floatArg(f *float64) interface{} {
+	if f == nil {
+		return nil
+	}
+	return *f
+}
+
+const scheduleColumns = "id, name, siteAlias, deviceAlias, days, time, state, setpoint, enabled"
+
+func scanSchedule(row rowScanner) (weeklySchedule, error) {
+	var s weeklySchedule
+	var days string
+	var state sql.NullString
+	var setpoint sql.NullFloat64
+	err := row.Scan(&s.ID, &s.Name, &s.SiteAlias, &s.DeviceAlias, &days, &s.Time, &state, &setpoint, &s.Enabled)
+	s.Days = splitDays(days)
+	s.State = state.String
+	s.Setpoint = nullableFloat(setpoint)
+	return s, err
+}
+
+func listSchedules(query string, args ...interface{}) ([]weeklySchedule, error) {
+	rows, err := queryDB(context.Background(), "schedules", "SELECT "+scheduleColumns+" FROM Schedule "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := []weeklySchedule{}
+	for rows.Next() {
+		s, err := scanSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, s)
+	}
+	return schedules, rows.Err()
+}
+
+const overrideColumns = "id, siteAlias, deviceAlias, runAt, until, state, setpoint, note"
+
+func scanOverride(row rowScanner) (scheduleOverride, error) {
+	var o scheduleOverride
+	var until sql.NullTime
+	var state, note sql.NullString
+	var setpoint sql.NullFloat64
+	err := row.Scan(&o.ID, &o.SiteAlias, &o.DeviceAlias, &o.RunAt, &until, &state, &setpoint, &note)
+	o.RunAt = localTime(o.RunAt)
+	o.Until = nullableTime(until)
+	o.State = state.String
+	o.Setpoint = nullableFloat(setpoint)
+	o.Note = note.String
+	return o, err
+}
+
+func listOverrides(query string, args ...interface{}) ([]scheduleOverride, error) {
+	rows, err := queryDB(context.Background(), "schedule_overrides", "SELECT "+overrideColumns+" FROM ScheduleOverride "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	overrides := []scheduleOverride{}
+	for rows.Next() {
+		o, err := scanOverride(rows)
+		if err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, o)
+	}
+	return overrides, rows.Err()
+}
+
+const jobColumns = "id, scheduleId, overrideId, siteAlias, deviceAlias, state, setpoint, dueAt, status, executedAt, correlationId, message"
+
+func scanJob(row rowScanner) (scheduleJob, error) {
+	var j scheduleJob
+	var scheduleID, overrideID sql.NullInt64
+	var state, correlationID, message sql.NullString
+	var setpoint sql.NullFloat64
+	var executedAt sql.NullTime
+	err := row.Scan(&j.ID, &scheduleID, &overrideID, &j.SiteAlias, &j.DeviceAlias, &state, &setpoint, &j.DueAt,
+		&j.Status, &executedAt, &correlationID, &message)
+	if scheduleID.Valid {
+		j.ScheduleID = &scheduleID.Int64
+	}
+	if overrideID.Valid {
+		j.OverrideID = &overrideID.Int64
+	}
+	j.State = state.String
+	j.Setpoint = nullableFloat(setpoint)
+	j.DueAt = localTime(j.DueAt)
+	j.ExecutedAt = nullableTime(executedAt)
+	j.CorrelationID = correlationID.String
+	j.Message = message.String
+	return j, err
+}
+
+func listJobs(query string, args ...interface{}) ([]scheduleJob, error) {
+	rows, err := queryDB(context.Background(), "schedule_jobs", "SELECT "+jobColumns+" FROM ScheduleJob "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := []scheduleJob{}
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs, rows.Err()
+}
+
+/* KODE PROGRAM - EKSEKUSI JADWAL */
+
+// runScheduler plans and executes jobs once per interval.
+func runScheduler(ctx context.Context, interval time.Duration) {
+	for {
+		now := time.Now().In(indonesiaLocation)
+		if err := planScheduleJobs(now); err != nil {
+			log.Printf("Gagal merencanakan jadwal: %v", err)
+		}
+		if err := executeDueJobs(now); err != nil {
+			log.Printf("Gagal menjalankan jadwal: %v", err)
+		}
+		if !sleepContext(ctx, interval) {
+			return
+		}
+	}
+}
+
+// occurrences lists the times a weekly schedule fires in [from, to).
+func (s weeklySchedule) occurrences(from, to time.Time) []time.Time {
+	minute, err := parseClock(s.Time)
+	if err != nil {
+		return nil
+	}
+	days := map[time.Weekday]bool{}
+	for _, day := range s.Days {
+		days[time.Weekday(day)] = true
+	}
+
+	var times []time.Time
+	start := from.In(scheduleLocation)
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, scheduleLocation); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !days[day.Weekday()] {
+			continue
+		}
+		at := day.Add(time.Duration(minute) * time.Minute)
+		if !at.Before(from) && at.Before(to) {
+			times = append(times, at)
+		}
+	}
+	return times
+}
+
+func jobExists(column string, id int64, dueAt time.Time) (bool, error) {
+	var exists bool
+	err := queryRowDB(context.Background(), "schedule_job_exists", "SELECT EXISTS(SELECT 1 FROM ScheduleJob WHERE "+column+" = ? AND dueAt = ?)",
+		id, dueAt.In(indonesiaLocation).Format(dbTimeLayout)).Scan(&exists)
+	return exists, err
+}
+
+func insertJob(scheduleID, overrideID interface{}, siteAlias, deviceAlias, state string, setpoint *float64, dueAt time.Time, status, message string) error {
+	_, err := execDB(context.Background(), "schedule_job_insert", `
+        INSERT INTO ScheduleJob (scheduleId, overrideId, siteAlias, deviceAlias, state, setpoint, dueAt, status, message)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		scheduleID, overrideID, siteAlias, deviceAlias, nullableString(state), floatArg(setpoint),
+		dueAt.In(indonesiaLocation).Format(dbTimeLayout), status, nullableString(message))
+	return err
+}
+
+// planScheduleJobs materialises weekly occurrences and overrides between
+// now-catchUpMaxAge and now+scheduleHorizon as ScheduleJob rows. Weekly
+// occurrences covered by an override window are stored as skipped.
+func planScheduleJobs(now time.Time) error {
+	from := now.Add(-catchUpMaxAge)
+	to := now.Add(scheduleHorizon)
+
+	overrides, err := listOverrides("WHERE runAt < ? AND COALESCE(until, runAt) >= ?",
+		to.Format(dbTimeLayout), from.Format(dbTimeLayout))
+	if err != nil {
+		return err
+	}
+	for _, o := range overrides {
+		exists, err := jobExists("overrideId", o.ID, o.RunAt)
+		if err != nil {
+			return err
+		}
+		if !exists && !o.RunAt.Before(from) {
+			if err := insertJob(nil, o.ID, o.SiteAlias, o.DeviceAlias, o.State, o.Setpoint, o.RunAt, jobPending, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	schedules, err := listSchedules("WHERE enabled = 1 ORDER BY id")
+	if err != nil {
+		return err
+	}
+	for _, s := range schedules {
+		for _, at := range s.occurrences(from, to) {
+			exists, err := jobExists("scheduleId", s.ID, at)
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+
+			status, message := jobPending, ""
+			for _, o := range overrides {
+				if o.SiteAlias == s.SiteAlias && o.DeviceAlias == s.DeviceAlias && o.Until != nil &&
+					!at.Before(o.RunAt) && at.Before(*o.Until) {
+					status, message = jobSkipped, fmt.Sprintf("ditimpa override %d", o.ID)
+				}
+			}
+			if err := insertJob(s.ID, nil, s.SiteAlias, s.DeviceAlias, s.State, s.Setpoint, at, status, message); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func finishJob(id int64, status, correlationID, message string) {
+	_, err := execDB(context.Background(), "schedule_job_finish", "UPDATE ScheduleJob SET status = ?, executedAt = ?, correlationId = ?, message = ? WHERE id = ?",
+		status, time.Now().In(indonesiaLocation).Format(dbTimeLayout), nullableString(correlationID), nullableString(message), id)
+	if err != nil {
+		log.Printf("Gagal memperbarui job jadwal %d: %v", id, err)
+	}
+}
+
+// executeDueJobs runs pending jobs that are due, latest per device first.
+// While the broker is unreachable due jobs stay pending until they are older
+// than catchUpMaxAge.
+func executeDueJobs(now time.Time) error {
+	due, err := listJobs("WHERE status = ? AND dueAt <= ? ORDER BY dueAt", jobPending, now.Format(dbTimeLayout))
+	if err != nil {
+		return err
+	}
+
+	latest := map[string]scheduleJob{}
+	for _, job := range due {
+		key := job.SiteAlias + "/" + job.DeviceAlias
+		if now.Sub(job.DueAt) > catchUpMaxAge {
+			finishJob(job.ID, jobMissed, "", "terlewat saat layanan tidak berjalan")
+			continue
+		}
+		if previous, ok := latest[key]; ok {
+			finishJob(previous.ID, jobSkipped, "", fmt.Sprintf("digantikan job %d", job.ID))
+		}
+		latest[key] = job
+	}
+
+	// Tanpa broker perintah pasti gagal; biarkan job tetap pending dan
+	// coba lagi pada putaran berikutnya.
+	if len(latest) > 0 && (mqttClient == nil || !mqttClient.IsConnectionOpen()) {
+		log.Printf("Broker MQTT tidak terhubung, %d job jadwal ditunda", len(latest))
+		return nil
+	}
+
+	keys := make([]string, 0, len(latest))
+	for key := range latest {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		job := latest[key]
+		ctx, cancel := context.WithTimeout(context.Background(), controlAckTimeout+5*time.Second)
+		result, err := sendControlCommand(ctx, job.SiteAlias, job.DeviceAlias, controlCommand{
+			State:    job.State,
+			Setpoint: job.Setpoint,
+			Source:   fmt.Sprintf("schedule:%d", job.ID),
+		})
+		cancel()
+		if err != nil {
+			log.Printf("Job jadwal %d (%s) gagal: %v", job.ID, key, err)
+			finishJob(job.ID, jobFailed, "", err.Error())
+			continue
+		}
+		log.Printf("Job jadwal %d (%s) selesai: %s", job.ID, key, result.State)
+		finishJob(job.ID, jobDone, result.CorrelationID, "")
+	}
+	return nil
+}
+
+/* KODE PROGRAM - API JADWAL */
+
+func getSchedules(w http.ResponseWriter, r *http.Request) {
+	scope, args := siteCondition(r, platform.PermRead, "siteAlias")
+	schedules, err := listSchedules("WHERE "+scope+" ORDER BY id", args...)
+	if err != nil {
+		log.Printf("Error querying schedules: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil jadwal")
+		return
+	}
+	writeJSON(w, http.StatusOK, schedules)
+}
+
+func getSchedule(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !requireRecordSite(w, r, platform.PermRead, "Schedule", id) {
+		return
+	}
+	s, err := scanSchedule(queryRowDB(r.Context(), "schedule_by_id", "SELECT "+scheduleColumns+" FROM Schedule WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		writeError(w, http.StatusNotFound, "Jadwal tidak ditemukan")
+		return
+	}
+	if err != nil {
+		log.Printf("Error querying schedule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil jadwal")
+		return
+	}
+	writeJSON(w, http.StatusOK, s)
+}
+
+func decodeSchedule(r *http.Request) (weeklySchedule, error) {
+	s := weeklySchedule{Enabled: true}
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		return s, fmt.Errorf("body JSON tidak valid: %v", err)
+	}
+	return s, s.validate()
+}
+
+// clearPendingJobs drops planned jobs of a schedule so they are planned again
+// from its current definition.
+func clearPendingJobs(scheduleID int64) {
+	if _, err := execDB(context.Background(), "schedule_jobs_clear", "DELETE FROM ScheduleJob WHERE scheduleId = ? AND status = ?", scheduleID, jobPending); err != nil {
+		log.Printf("Gagal menghapus job jadwal %d: %v", scheduleID, err)
+	}
+}
+
+func createSchedule(w http.ResponseWriter, r *http.Request) {
+	s, err := decodeSchedule(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !requireSite(w, r, platform.PermControl, s.SiteAlias) {
+		return
+	}
+
+	now := time.Now().In(indonesiaLocation).Format(dbTimeLayout)
+	res, err := execDB(r.Context(), "schedule_insert", `
+        INSERT INTO Schedule (name, siteAlias, deviceAlias, days, time, state, setpoint, enabled, created, updated)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		s.Name, s.SiteAlias, s.DeviceAlias, joinDays(s.Days), s.Time, nullableString(s.State), floatArg(s.Setpoint), s.Enabled, now, now)
+	if err != nil {
+		log.Printf("Error inserting schedule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menyimpan jadwal")
+		return
+	}
+	s.ID, _ = res.LastInsertId()
+	writeJSON(w, http.StatusCreated, s)
+}
+
+func updateSchedule(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "id tidak valid")
+		return
+	}
+	s, err := decodeSchedule(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.ID = id
+	if !requireSite(w, r, platform.PermControl, s.SiteAlias) || !requireRecordSite(w, r, platform.PermControl, "Schedule", id) {
+		return
+	}
+
+	res, err := execDB(r.Context(), "schedule_update", `
+        UPDATE Schedule SET name = ?, siteAlias = ?, deviceAlias = ?, days = ?, time = ?, state = ?, setpoint = ?, enabled = ?, updated = ?
+        WHERE id = ?`,
+		s.Name, s.SiteAlias, s.DeviceAlias, joinDays(s.Days), s.Time, nullableString(s.State), floatArg(s.Setpoint), s.Enabled,
+		time.Now().In(indonesiaLocation).Format(dbTimeLayout), id)
+	if err != nil {
+		log.Printf("Error updating schedule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal memperbarui jadwal")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		var exists bool
+		if err := queryRowDB(r.Context(), "schedule_exists", "SELECT EXISTS(SELECT 1 FROM Schedule WHERE id = ?)", id).Scan(&exists); err != nil {
+			log.Printf("Error checking schedule: %v", err)
+			writeError(w, http.StatusInternalServerError, "Gagal memperbarui jadwal")
+			return
+		}
+		if !exists {
+			writeError(w, http.StatusNotFound, "Jadwal tidak ditemukan")
+			return
+		}
+	}
+
+	clearPendingJobs(id)
+	writeJSON(w, http.StatusOK, s)
+}
+
+func deleteSchedule(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "id tidak valid")
+		return
+	}
+	if !requireRecordSite(w, r, platform.PermControl, "Schedule", id) {
+		return
+	}
+	res, err := execDB(r.Context(), "schedule_delete", "DELETE FROM Schedule WHERE id = ?", id)
+	if err != nil {
+		log.Printf("Error deleting schedule: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menghapus jadwal")
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		writeError(w, http.StatusNotFound, "Jadwal tidak ditemukan")
+		return
+	}
+
+	clearPendingJobs(id)
+	writeJSON(w, http.StatusOK, map[string]string{"message": "Sukses"})
+}
+
+func getOverrides(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseTimeRange(r, 30*24*time.Hour)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if r.URL.Query().Get("to") == "" {
+		to = to.Add(365 * 24 * time.Hour)
+	}
+
+	scope, scopeArgs := siteCondition(r, platform.PermRead, "siteAlias")
+	args := append([]interface{}{from.Format(dbTimeLayout), to.Format(dbTimeLayout)}, scopeArgs...)
+	overrides, err := listOverrides("WHERE runAt >= ? AND runAt < ? AND "+scope+" ORDER BY runAt", args...)
+	if err != nil {
+		log.Printf("Error querying overrides: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil override")
+		return
+	}
+	writeJSON(w, http.StatusOK, overrides)
+}
+
+type overrideRequest struct {
+	SiteAlias   string   `json:"siteAlias"`
+	DeviceAlias string   `json:"deviceAlias"`
+	RunAt       string   `json:"runAt"`
+	Until       string   `json:"until"`
+	State       string   `json:"state"`
+	Setpoint    *float64 `json:"setpoint"`
+	Note        string   `json:"note"`
+}
+
+func createOverride(w http.ResponseWriter, r *http.Request) {
+	var req overrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "body JSON tidak valid")
+		return
+	}
+	if err := validateSwitchTarget(req.SiteAlias, req.DeviceAlias, req.State, req.Setpoint); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !requireSite(w, r, platform.PermControl, req.SiteAlias) {
+		return
+	}
+	runAt, err := parseQueryTime(req.RunAt)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	o := scheduleOverride{SiteAlias: req.SiteAlias, DeviceAlias: req.DeviceAlias, RunAt: runAt, State: req.State, Setpoint: req.Setpoint, Note: req.Note}
+	var until interface{}
+	if req.Until != "" {
+		u, err := parseQueryTime(req.Until)
+		if err != nil || !u.After(runAt) {
+			writeError(w, http.StatusBadRequest, "until harus setelah runAt")
+			return
+		}
+		o.Until = &u
+		until = u.Format(dbTimeLayout)
+	}
+
+	if err := insertOverride(r.Context(), &o, until); err != nil {
+		log.Printf("Error inserting override: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menyimpan override")
+		return
+	}
+	writeJSON(w, http.StatusCreated, o)
+}
+
+// insertOverride stores o and skips the weekly jobs already planned inside
+// its window in one transaction.
+func insertOverride(ctx context.Context, o *scheduleOverride, until interface{}) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := execTx(ctx, tx, "schedule_override_insert", `
+        INSERT INTO ScheduleOverride (siteAlias, deviceAlias, runAt, until, state, setpoint, note, created)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		o.SiteAlias, o.DeviceAlias, o.RunAt.Format(dbTimeLayout), until, nullableString(o.State), floatArg(o.Setpoint),
+		nullableString(o.Note), time.Now().In(indonesiaLocation).Format(dbTimeLayout))
+	if err != nil {
+		return err
+	}
+	if o.ID, err = res.LastInsertId(); err != nil {
+		return err
+	}
+
+	// Job mingguan yang sudah direncanakan di dalam jendela override dilewati
+	if o.Until != nil {
+		if _, err := execTx(ctx, tx, "schedule_jobs_supersede", `UPDATE ScheduleJob SET status = ?, message = ?
+            WHERE siteAlias = ? AND deviceAlias = ? AND scheduleId IS NOT NULL AND status = ? AND dueAt >= ? AND dueAt < ?`,
+			jobSkipped, fmt.Sprintf("ditimpa override %d", o.ID), o.SiteAlias, o.DeviceAlias, jobPending,
+			o.RunAt.Format(dbTimeLayout), o.Until.Format(dbTimeLayout)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func deleteOverride(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "id tidak valid")
+		return
+	}
+	if !requireRecordSite(w, r, platform.PermControl, "ScheduleOverride", id) {
+		return
+	}
+	found, err := removeOverride(r.Context(), id)
+	if err != nil {
+		log.Printf("Error deleting override: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal menghapus override")
+		return
+	}
+	if !found {
+		writeError(w, http.StatusNotFound, "Override tidak ditemukan")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"message": "Sukses"})
+}
+
+// removeOverride deletes an override with its pending jobs and restores the
+// weekly jobs it had skipped, in one transaction.
+func removeOverride(ctx context.Context, id int64) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := execTx(ctx, tx, "schedule_override_delete", "DELETE FROM ScheduleOverride WHERE id = ?", id)
+	if err != nil {
+		return false, err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+	if _, err := execTx(ctx, tx, "schedule_override_jobs_clear", "DELETE FROM ScheduleJob WHERE overrideId = ? AND status = ?", id, jobPending); err != nil {
+		return false, err
+	}
+	if _, err := execTx(ctx, tx, "schedule_jobs_restore", "UPDATE ScheduleJob SET status = ?, message = NULL WHERE status = ? AND message = ?",
+		jobPending, jobSkipped, fmt.Sprintf("ditimpa override %d", id)); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// getScheduleJobs lists upcoming and executed jobs, filtered by status, site
+// and device over a time range (default: the last and next 24 hours).
+func getScheduleJobs(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	now := time.Now().In(indonesiaLocation)
+	from, to := now.Add(-24*time.Hour), now.Add(scheduleHorizon)
+	if q.Get("from") != "" || q.Get("to") != "" {
+		var err error
+		if from, to, err = parseTimeRange(r, 24*time.Hour); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	scope, scopeArgs := siteCondition(r, platform.PermRead, "siteAlias")
+	query := "WHERE dueAt >= ? AND dueAt < ? AND " + scope
+	args := append([]interface{}{from.Format(dbTimeLayout), to.Format(dbTimeLayout)}, scopeArgs...)
+	for _, filter := range []struct{ param, column string }{
+		{"status", "status"}, {"site", "siteAlias"}, {"device", "deviceAlias"},
+	} {
+		if value := q.Get(filter.param); value != "" {
+			query += " AND " + filter.column + " = ?"
+			args = append(args, value)
+		}
+	}
+
+	jobs, err := listJobs(query+" ORDER BY dueAt", args...)
+	if err != nil {
+		log.Printf("Error querying schedule jobs: %v", err)
+		writeError(w, http.StatusInternalServerError, "Gagal mengambil job jadwal")
+		return
+	}
+	writeJSON(w, http.StatusOK, jobs)
+}
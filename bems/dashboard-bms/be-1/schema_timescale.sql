@@ -0,0 +1,36 @@
+-- Skema TimescaleDB untuk bacaan sensor (timeseries.driver: timescale).
+-- Hanya Value yang disalin ke sini; be-1 tetap menulisnya juga ke MariaDB
+-- untuk soft-sensor. Stat tetap hanya di MariaDB.
+-- Setiap pernyataan dijalankan terpisah karena continuous aggregate tidak
+-- boleh dibuat di dalam transaksi; jalankan ulang aman.
+
+CREATE EXTENSION IF NOT EXISTS timescaledb;
+
+CREATE TABLE IF NOT EXISTS value (
+  device_id text NOT NULL,
+  created timestamptz NOT NULL,
+  value double precision NOT NULL
+);
+SELECT create_hypertable('value', 'created', chunk_time_interval => INTERVAL '7 days', if_not_exists => TRUE);
+CREATE INDEX IF NOT EXISTS value_device_id_created_idx ON value (device_id, created DESC);
+
+-- Ringkasan per jam untuk grafik rentang panjang. materialized_only = false
+-- menggabungkan bucket yang belum dimaterialisasi dengan data mentah.
+CREATE MATERIALIZED VIEW IF NOT EXISTS value_hourly
+WITH (timescaledb.continuous, timescaledb.materialized_only = false) AS
+SELECT device_id,
+       time_bucket(INTERVAL '1 hour', created) AS bucket,
+       sum(value) AS sum,
+       count(*) AS count,
+       min(value) AS min,
+       max(value) AS max,
+       first(value, created) AS first,
+       last(value, created) AS last
+FROM value
+GROUP BY device_id, bucket
+WITH NO DATA;
+SELECT add_continuous_aggregate_policy('value_hourly',
+  start_offset => INTERVAL '3 days',
+  end_offset => INTERVAL '1 hour',
+  schedule_interval => INTERVAL '30 minutes',
+  if_not_exists => TRUE);
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"platform"
+)
+
+/* KODE PROGRAM - AUTENTIKASI JWT */
+
+// jwtSecret is the HS256 key shared with be-3 (SECRET_KEY). Without it every
+// protected route answers 401.
+var jwtSecret []byte
+
+// publicRoutes are "METHOD /path/template" entries served without a token.
+// Only read-only routes belong here; see AUTH_PUBLIC_ROUTES.
+var publicRoutes = map[string]bool{
+	"GET /monitoring/{fileName}":            true,
+	"GET /api/available-models":             true,
+	"GET /api/heatmap/{roomId}/{parameter}": true,
+}
+
+// authMiddleware sends device routes to authenticateDevice; every other
+// route goes through the user token check shared with be-1, which lets
+// public routes through and sets the identity of a valid token.
+func authMiddleware(next http.Handler) http.Handler {
+	users := userAuth().Middleware(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if deviceRoutes[platform.RouteKey(r)] {
+			authenticateDevice(w, r, next)
+			return
+		}
+		users.ServeHTTP(w, r)
+	})
+}
+
+func userAuth() platform.Auth {
+	return platform.Auth{
+		Secret:       jwtSecret,
+		PublicRoutes: publicRoutes,
+		Accept: func(r *http.Request, id *platform.Identity) {
+			setAuditActor(r, id.Subject)
+		},
+		Reject: rejectToken,
+	}
+}
+
+// rejectToken answers 401, logging tokens that were presented but refused.
+func rejectToken(w http.ResponseWriter, r *http.Request, err error) {
+	if err != platform.ErrTokenMissing {
+		log.Printf("Token ditolak untuk %s dari %s: %v", platform.RouteKey(r), clientIP(r), err)
+	}
+	writeStatusError(w, http.StatusUnauthorized, err.Error())
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"gopkg.in/yaml.v3"
+
+	"platform"
+)
+
+/* KODE PROGRAM - KONFIGURASI */
+
+// duration reads Go duration strings ("24h", "30m") from YAML and env.
+type duration struct {
+	time.Duration
+}
+
+func (d *duration) UnmarshalYAML(value *yaml.Node) error {
+	parsed, err := time.ParseDuration(value.Value)
+	if err != nil {
+		return fmt.Errorf("durasi tidak valid %q", value.Value)
+	}
+	d.Duration = parsed
+	return nil
+}
+
+// minioSettings locates the object store holding models, images and heatmaps.
+type minioSettings struct {
+	Endpoint  string `yaml:"endpoint"`
+	AccessKey string `yaml:"accessKey"`
+	SecretKey string `yaml:"secretKey"`
+	Bucket    string `yaml:"bucket"`
+	ModelPath string `yaml:"modelPath"`
+	UseSSL    bool   `yaml:"useSSL"`
+}
+
+// config is everything be-2 needs at startup. Values come from the defaults
+// below, then the optional YAML file, then environment variables.
+type config struct {
+	HTTP struct {
+		Addr           string   `yaml:"addr"`
+		AllowedOrigins []string `yaml:"allowedOrigins"`
+		TrustedProxies []string `yaml:"trustedProxies"`
+		MetricsAddr    string   `yaml:"metricsAddr"`
+	} `yaml:"http"`
+	Database struct {
+		Driver  string `yaml:"driver"`
+		DSN     string `yaml:"dsn"`
+		Migrate bool   `yaml:"migrate"`
+	} `yaml:"database"`
+	MinIO minioSettings `yaml:"minio"`
+	Auth  struct {
+		JWTSecret    string   `yaml:"jwtSecret"`
+		PublicRoutes []string `yaml:"publicRoutes"`
+		Admins       []string `yaml:"admins"`
+	} `yaml:"auth"`
+	Logs struct {
+		Dir        string   `yaml:"dir"`
+		Level      string   `yaml:"level"`
+		MaxSizeMB  int      `yaml:"maxSizeMB"`
+		MaxAge     duration `yaml:"maxAge"`
+		MaxBackups int      `yaml:"maxBackups"`
+	} `yaml:"logs"`
+	Tracing struct {
+		Exporter    string  `yaml:"exporter"`
+		Endpoint    string  `yaml:"endpoint"`
+		SampleRatio float64 `yaml:"sampleRatio"`
+	} `yaml:"tracing"`
+}
+
+func defaultConfig() config {
+	var cfg config
+	cfg.HTTP.Addr = ":10005"
+	cfg.HTTP.MetricsAddr = ":9105"
+	cfg.HTTP.AllowedOrigins = []string{"http://10.46.7.51:10006", "http://localhost:10006", "http://172.35.0.7:10006"}
+	cfg.Database.Driver = "mysql"
+	cfg.Database.Migrate = true
+	cfg.MinIO.Bucket = "heb2024"
+	cfg.MinIO.ModelPath = "heb2024/model"
+	cfg.Logs.Dir = "logs"
+	cfg.Logs.Level = "info"
+	cfg.Logs.MaxSizeMB = 50
+	cfg.Logs.MaxAge = duration{24 * time.Hour}
+	cfg.Logs.MaxBackups = 7
+	cfg.Tracing.Exporter = "none"
+	cfg.Tracing.Endpoint = "http://otel-collector:4318"
+	cfg.Tracing.SampleRatio = 1
+	return cfg
+}
+
+func splitList(s string) []string {
+	list := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// envOverrides maps each environment variable onto its config field.
+func (c *config) envOverrides() map[string]func(string) error {
+	str := func(field *string) func(string) error {
+		return func(v string) error { *field = v; return nil }
+	}
+	list := func(field *[]string) func(string) error {
+		return func(v string) error { *field = splitList(v); return nil }
+	}
+	boolean := func(field *bool) func(string) error {
+		return func(v string) error {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("nilai boolean tidak valid %q", v)
+			}
+			*field = b
+			return nil
+		}
+	}
+	integer := func(field *int) func(string) error {
+		return func(v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("bilangan bulat tidak valid %q", v)
+			}
+			*field = n
+			return nil
+		}
+	}
+	dur := func(field *duration) func(string) error {
+		return func(v string) error {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("durasi tidak valid %q", v)
+			}
+			field.Duration = d
+			return nil
+		}
+	}
+	number := func(field *float64) func(string) error {
+		return func(v string) error {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return fmt.Errorf("angka tidak valid %q", v)
+			}
+			*field = f
+			return nil
+		}
+	}
+
+	return map[string]func(string) error{
+		"HTTP_ADDR":            str(&c.HTTP.Addr),
+		"CORS_ALLOWED_ORIGINS": list(&c.HTTP.AllowedOrigins),
+		"TRUSTED_PROXIES":      list(&c.HTTP.TrustedProxies),
+		"METRICS_ADDR":         str(&c.HTTP.MetricsAddr),
+		"DATABASE_DRIVER":      str(&c.Database.Driver),
+		"DATABASE_DSN":         str(&c.Database.DSN),
+		"DATABASE_MIGRATE":     boolean(&c.Database.Migrate),
+		"MINIO_ENDPOINT":       str(&c.MinIO.Endpoint),
+		"MINIO_ACCESS_KEY":     str(&c.MinIO.AccessKey),
+		"MINIO_SECRET_KEY":     str(&c.MinIO.SecretKey),
+		"MINIO_BUCKET":         str(&c.MinIO.Bucket),
+		"MINIO_MODEL_PATH":     str(&c.MinIO.ModelPath),
+		"MINIO_USE_SSL":        boolean(&c.MinIO.UseSSL),
+		"JWT_SECRET":           str(&c.Auth.JWTSecret),
+		"AUTH_PUBLIC_ROUTES":   list(&c.Auth.PublicRoutes),
+		"RBAC_ADMINS":          list(&c.Auth.Admins),
+		"LOG_DIR":              str(&c.Logs.Dir),
+		"LOG_LEVEL":            str(&c.Logs.Level),
+		"LOG_MAX_SIZE_MB":      integer(&c.Logs.MaxSizeMB),
+		"LOG_MAX_AGE":          dur(&c.Logs.MaxAge),
+		"LOG_MAX_BACKUPS":      integer(&c.Logs.MaxBackups),
+		"TRACING_EXPORTER":     str(&c.Tracing.Exporter),
+		"TRACING_ENDPOINT":     str(&c.Tracing.Endpoint),
+		"TRACING_SAMPLE_RATIO": number(&c.Tracing.SampleRatio),
+	}
+}
+
+// loadConfig builds the configuration from path (may be empty) and the
+// environment, then validates it.
+func loadConfig(path string) (config, error) {
+	cfg := defaultConfig()
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return cfg, err
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return cfg, fmt.Errorf("gagal membaca %s: %v", path, err)
+		}
+	}
+
+	var errs []error
+	for name, apply := range cfg.envOverrides() {
+		if v, ok := os.LookupEnv(name); ok {
+			if err := apply(v); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", name, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return cfg, errors.Join(errs...)
+	}
+	return cfg, cfg.validate()
+}
+
+// validate reports every invalid field at once.
+func (c *config) validate() error {
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.HTTP.Addr == "" {
+		fail("http.addr wajib diisi")
+	}
+	if c.HTTP.MetricsAddr != "" && c.HTTP.MetricsAddr == c.HTTP.Addr {
+		fail("http.metricsAddr harus berbeda dari http.addr")
+	}
+	if len(c.HTTP.AllowedOrigins) == 0 {
+		fail("http.allowedOrigins wajib diisi")
+	}
+	for _, origin := range c.HTTP.AllowedOrigins {
+		if u, err := url.Parse(origin); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			fail("origin tidak valid: %q", origin)
+		}
+	}
+	if _, err := parseTrustedProxies(c.HTTP.TrustedProxies); err != nil {
+		fail("http.trustedProxies: %v", err)
+	}
+
+	switch {
+	case c.Database.Driver != "mysql" && c.Database.Driver != "sqlite":
+		fail("database.driver harus mysql atau sqlite: %q", c.Database.Driver)
+	case c.Database.DSN == "":
+		fail("database.dsn wajib diisi (DATABASE_DSN)")
+	case c.Database.Driver == "mysql":
+		if _, err := mysql.ParseDSN(c.Database.DSN); err != nil {
+			fail("database.dsn tidak valid: %v", err)
+		}
+	}
+
+	if c.MinIO.Endpoint == "" {
+		fail("minio.endpoint wajib diisi (MINIO_ENDPOINT)")
+	} else if strings.Contains(c.MinIO.Endpoint, "://") {
+		fail("minio.endpoint berupa host:port tanpa skema: %q", c.MinIO.Endpoint)
+	}
+	if c.MinIO.AccessKey == "" || c.MinIO.SecretKey == "" {
+		fail("minio.accessKey dan minio.secretKey wajib diisi (MINIO_ACCESS_KEY, MINIO_SECRET_KEY)")
+	}
+	if c.MinIO.Bucket == "" {
+		fail("minio.bucket wajib diisi")
+	}
+	c.MinIO.ModelPath = strings.Trim(c.MinIO.ModelPath, "/")
+
+	if len(c.Auth.PublicRoutes) > 0 {
+		if _, err := platform.ParsePublicRoutes(strings.Join(c.Auth.PublicRoutes, ",")); err != nil {
+			fail("auth.publicRoutes: %v", err)
+		}
+	}
+	if c.Logs.Dir == "" {
+		fail("logs.dir wajib diisi")
+	}
+	if _, err := platform.ParseLogLevel(c.Logs.Level); err != nil {
+		fail("logs.level: %v", err)
+	}
+	if c.Logs.MaxSizeMB <= 0 {
+		fail("logs.maxSizeMB harus lebih dari 0")
+	}
+	if c.Logs.MaxAge.Duration <= 0 {
+		fail("logs.maxAge harus lebih dari 0")
+	}
+	if c.Logs.MaxBackups < 0 {
+		fail("logs.maxBackups tidak boleh negatif")
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if u, err := url.Parse(c.Tracing.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			fail("tracing.endpoint harus URL OTLP/HTTP: %q", c.Tracing.Endpoint)
+		}
+	default:
+		fail("tracing.exporter harus none, stdout atau otlp: %q", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		fail("tracing.sampleRatio harus antara 0 dan 1")
+	}
+
+	return errors.Join(errs...)
+}
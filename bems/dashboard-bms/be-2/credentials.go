@@ -0,0 +1,477 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"platform"
+)
+
+/* KODE PROGRAM - KREDENSIAL PERANGKAT */
+
+// Device keys look like "<keyId>.<secret>". Only the SHA-256 of the secret
+// is stored; the full key is shown once when it is issued or rotated.
+// Devices send it as "Authorization: Device <key>" or in X-Device-Key.
+
+// deviceRoutes are written by field devices with a device key instead of a
+// user token.
+var deviceRoutes = map[string]bool{
+	"POST /upload": true,
+}
+
+// defaultRotationGrace keeps the previous secret valid after a rotation so
+// devices can be updated without losing uploads.
+const defaultRotationGrace = 24 * time.Hour
+
+var (
+	errDeviceKeyMissing = errors.New("kunci perangkat tidak ditemukan")
+	errDeviceKeyInvalid = errors.New("kunci perangkat tidak valid")
+	errDeviceKeyRevoked = errors.New("kunci perangkat sudah dicabut")
+	errDeviceKeyExpired = errors.New("kunci perangkat kedaluwarsa")
+)
+
+// deviceCredential is a key and the Parameter ids it may write.
+type deviceCredential struct {
+	ID           int64    `json:"id"`
+	Name         string   `json:"name"`
+	KeyID        string   `json:"keyId"`
+	ParameterIDs []string `json:"parameterIds"`
+	ExpiresAt    string   `json:"expiresAt,omitempty"`
+	RevokedAt    string   `json:"revokedAt,omitempty"`
+	RotatedAt    string   `json:"rotatedAt,omitempty"`
+	LastUsedAt   string   `json:"lastUsedAt,omitempty"`
+	Created      string   `json:"created"`
+	CreatedBy    string   `json:"createdBy,omitempty"`
+}
+
+type deviceCredentialKey struct{}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func deviceKeyFromRequest(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Device ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Device "))
+	}
+	return strings.TrimSpace(r.Header.Get("X-Device-Key"))
+}
+
+// parseDBTime reads a datetime(3) column scanned as text. The SQLite driver
+// hands these columns back as time.Time, which database/sql renders as RFC
+// 3339 in UTC while the stored wall clock is still Asia/Jakarta.
+func parseDBTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), indonesiaLocation), nil
+	}
+	return time.ParseInLocation(dbTimeLayout, s, indonesiaLocation)
+}
+
+// verifyDeviceKey checks a presented key against the current secret and,
+// during the rotation grace period, the previous one.
+func verifyDeviceKey(ctx context.Context, key string, now time.Time) (*deviceCredential, error) {
+	keyID, secret, ok := strings.Cut(key, ".")
+	if !ok || keyID == "" || secret == "" {
+		return nil, errDeviceKeyInvalid
+	}
+
+	var c deviceCredential
+	var secretHash string
+	var previousHash, previousUntil, expiresAt, revokedAt sql.NullString
+	err := queryRowDB(ctx, "credential_by_key", `
+		SELECT id, name, keyId, secretHash, previousHash, previousUntil, expiresAt, revokedAt
+		FROM DeviceCredential WHERE keyId = ?`, keyID).Scan(
+		&c.ID, &c.Name, &c.KeyID, &secretHash, &previousHash, &previousUntil, &expiresAt, &revokedAt)
+	if err == sql.ErrNoRows {
+		return nil, errDeviceKeyInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	presented := hashSecret(secret)
+	valid := subtle.ConstantTimeCompare([]byte(presented), []byte(secretHash)) == 1
+	if !valid && previousHash.Valid && previousUntil.Valid {
+		until, err := parseDBTime(previousUntil.String)
+		valid = err == nil && now.Before(until) &&
+			subtle.ConstantTimeCompare([]byte(presented), []byte(previousHash.String)) == 1
+	}
+	if !valid {
+		return nil, errDeviceKeyInvalid
+	}
+	if revokedAt.Valid {
+		return nil, errDeviceKeyRevoked
+	}
+	if expiresAt.Valid {
+		if t, err := parseDBTime(expiresAt.String); err == nil && !now.Before(t) {
+			return nil, errDeviceKeyExpired
+		}
+	}
+
+	if c.ParameterIDs, err = credentialParameters(ctx, c.ID); err != nil {
+		return nil, err
+	}
+	execDB(ctx, "credential_touch", "UPDATE DeviceCredential SET lastUsedAt = ? WHERE id = ?", now.Format(dbTimeLayout), c.ID)
+	return &c, nil
+}
+
+func credentialParameters(ctx context.Context, id int64) ([]string, error) {
+	rows, err := queryDB(ctx, "credential_parameters", "SELECT parameterId FROM DeviceCredentialParameter WHERE credentialId = ? ORDER BY parameterId", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var parameterID string
+		if err := rows.Scan(&parameterID); err != nil {
+			return nil, err
+		}
+		ids = append(ids, parameterID)
+	}
+	return ids, rows.Err()
+}
+
+// authenticateDevice serves a device route once its key checks out.
+func authenticateDevice(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	key := deviceKeyFromRequest(r)
+	if key == "" {
+		writeStatusError(w, http.StatusUnauthorized, errDeviceKeyMissing.Error())
+		return
+	}
+	c, err := verifyDeviceKey(r.Context(), key, time.Now().In(indonesiaLocation))
+	if err != nil {
+		log.Printf("Kunci perangkat ditolak untuk %s dari %s: %v", platform.RouteKey(r), clientIP(r), err)
+		writeStatusError(w, http.StatusUnauthorized, errDeviceKeyInvalid.Error())
+		return
+	}
+
+	setAuditActor(r, "device:"+c.Name)
+	next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), deviceCredentialKey{}, c)))
+}
+
+// requireDeviceParameter answers 403 unless the request's device key is
+// bound to the Parameter id it writes.
+func requireDeviceParameter(w http.ResponseWriter, r *http.Request, parameterID string) bool {
+	if c, ok := r.Context().Value(deviceCredentialKey{}).(*deviceCredential); ok {
+		for _, id := range c.ParameterIDs {
+			if id == parameterID {
+				return true
+			}
+		}
+	}
+	writeStatusError(w, http.StatusForbidden, fmt.Sprintf("Kunci perangkat tidak berhak menulis deviceId %s", parameterID))
+	return false
+}
+
+/* KODE PROGRAM - API KREDENSIAL PERANGKAT */
+
+type credentialRequest struct {
+	Name         string   `json:"name"`
+	ParameterIDs []string `json:"parameterIds"`
+	ExpiresAt    string   `json:"expiresAt"`
+}
+
+// issuedCredential is returned once with the plain key.
+type issuedCredential struct {
+	deviceCredential
+	Key string `json:"key"`
+}
+
+func writeJSONResponse(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func validateParameterIDs(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("parameterIds wajib diisi")
+	}
+	for _, id := range ids {
+		exists, err := repo.Parameters.Exists(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("parameter %s tidak ditemukan", id)
+		}
+	}
+	return nil
+}
+
+func bindParameters(tx *sql.Tx, credentialID int64, ids []string) error {
+	if _, err := tx.Exec("DELETE FROM DeviceCredentialParameter WHERE credentialId = ?", credentialID); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err := tx.Exec("INSERT INTO DeviceCredentialParameter (credentialId, parameterId) VALUES (?, ?)", credentialID, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func actorName(r *http.Request) string {
+	if id, ok := platform.IdentityFrom(r); ok {
+		return id.Subject
+	}
+	return ""
+}
+
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func getDeviceCredentials(w http.ResponseWriter, r *http.Request) {
+	rows, err := queryDB(r.Context(), "credentials", `
+		SELECT id, name, keyId, expiresAt, revokedAt, rotatedAt, lastUsedAt, created, createdBy
+		FROM DeviceCredential ORDER BY id`)
+	if err != nil {
+		log.Printf("Error querying device credentials: %v", err)
+		writeStatusError(w, http.StatusInternalServerError, "Gagal mengambil kredensial perangkat")
+		return
+	}
+	defer rows.Close()
+
+	credentials := []deviceCredential{}
+	for rows.Next() {
+		var c deviceCredential
+		var expiresAt, revokedAt, rotatedAt, lastUsedAt, createdBy sql.NullString
+		if err := rows.Scan(&c.ID, &c.Name, &c.KeyID, &expiresAt, &revokedAt, &rotatedAt, &lastUsedAt, &c.Created, &createdBy); err != nil {
+			log.Printf("Error scanning device credential: %v", err)
+			writeStatusError(w, http.StatusInternalServerError, "Gagal membaca kredensial perangkat")
+			return
+		}
+		c.ExpiresAt, c.RevokedAt, c.RotatedAt = expiresAt.String, revokedAt.String, rotatedAt.String
+		c.LastUsedAt, c.CreatedBy = lastUsedAt.String, createdBy.String
+		credentials = append(credentials, c)
+	}
+	rows.Close()
+
+	for i := range credentials {
+		if credentials[i].ParameterIDs, err = credentialParameters(r.Context(), credentials[i].ID); err != nil {
+			log.Printf("Error querying credential parameters: %v", err)
+			writeStatusError(w, http.StatusInternalServerError, "Gagal membaca kredensial perangkat")
+			return
+		}
+	}
+	writeJSONResponse(w, http.StatusOK, credentials)
+}
+
+func createDeviceCredential(w http.ResponseWriter, r *http.Request) {
+	var req credentialRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeStatusError(w, http.StatusBadRequest, "body JSON tidak valid")
+		return
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		writeStatusError(w, http.StatusBadRequest, "name wajib diisi")
+		return
+	}
+	if err := validateParameterIDs(r.Context(), req.ParameterIDs); err != nil {
+		writeStatusError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var expiresAt interface{}
+	if req.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			writeStatusError(w, http.StatusBadRequest, "expiresAt harus RFC3339")
+			return
+		}
+		expiresAt = t.In(indonesiaLocation).Format(dbTimeLayout)
+	}
+
+	keyID, err := randomHex(8)
+	var secret string
+	if err == nil {
+		secret, err = randomHex(32)
+	}
+	if err != nil {
+		log.Printf("Error generating device key: %v", err)
+		writeStatusError(w, http.StatusInternalServerError, "Gagal membuat kunci perangkat")
+		return
+	}
+
+	now := time.Now().In(indonesiaLocation).Format(dbTimeLayout)
+	issued := issuedCredential{deviceCredential: deviceCredential{
+		Name:         req.Name,
+		KeyID:        keyID,
+		ParameterIDs: req.ParameterIDs,
+		Created:      now,
+		CreatedBy:    actorName(r),
+	}}
+	issued.Key = issued.KeyID + "." + secret
+	if s, ok := expiresAt.(string); ok {
+		issued.ExpiresAt = s
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		writeStatusError(w, http.StatusInternalServerError, "Gagal menyimpan kredensial perangkat")
+		return
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+		INSERT INTO DeviceCredential (name, keyId, secretHash, expiresAt, created, createdBy)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		issued.Name, issued.KeyID, hashSecret(secret), expiresAt, now, nullString(issued.CreatedBy))
+	if err == nil {
+		issued.ID, _ = res.LastInsertId()
+		err = bindParameters(tx, issued.ID, req.ParameterIDs)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		log.Printf("Error inserting device credential: %v", err)
+		writeStatusError(w, http.StatusInternalServerError, "Gagal menyimpan kredensial perangkat")
+		return
+	}
+	writeJSONResponse(w, http.StatusCreated, issued)
+}
+
+func credentialID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		writeStatusError(w, http.StatusBadRequest, "id tidak valid")
+		return 0, false
+	}
+	var exists bool
+	if err := queryRowDB(r.Context(), "credential_exists", "SELECT EXISTS(SELECT 1 FROM DeviceCredential WHERE id = ?)", id).Scan(&exists); err != nil {
+		log.Printf("Error checking device credential: %v", err)
+		writeStatusError(w, http.StatusInternalServerError, "Gagal membaca kredensial perangkat")
+		return 0, false
+	}
+	if !exists {
+		writeStatusError(w, http.StatusNotFound, "Kredensial perangkat tidak ditemukan")
+		return 0, false
+	}
+	return id, true
+}
+
+// updateCredentialParameters replaces the Parameter ids a key may write.
+func updateCredentialParameters(w http.ResponseWriter, r *http.Request) {
+	id, ok := credentialID(w, r)
+	if !ok {
+		return
+	}
+	var req credentialRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeStatusError(w, http.StatusBadRequest, "body JSON tidak valid")
+		return
+	}
+	if err := validateParameterIDs(r.Context(), req.ParameterIDs); err != nil {
+		writeStatusError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := db.Begin()
+	if err == nil {
+		defer tx.Rollback()
+		if err = bindParameters(tx, id, req.ParameterIDs); err == nil {
+			err = tx.Commit()
+		}
+	}
+	if err != nil {
+		log.Printf("Error updating credential parameters: %v", err)
+		writeStatusError(w, http.StatusInternalServerError, "Gagal memperbarui kredensial perangkat")
+		return
+	}
+	writeJSONResponse(w, http.StatusOK, APIResponse{Message: "Sukses"})
+}
+
+// rotateDeviceCredential issues a new secret under the same keyId. The old
+// secret keeps working for ?grace= (default 24h, 0 ends it at once).
+func rotateDeviceCredential(w http.ResponseWriter, r *http.Request) {
+	id, ok := credentialID(w, r)
+	if !ok {
+		return
+	}
+	grace := defaultRotationGrace
+	if s := r.URL.Query().Get("grace"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d < 0 {
+			writeStatusError(w, http.StatusBadRequest, "grace tidak valid")
+			return
+		}
+		grace = d
+	}
+
+	var keyID string
+	var revokedAt sql.NullString
+	if err := queryRowDB(r.Context(), "credential_key", "SELECT keyId, revokedAt FROM DeviceCredential WHERE id = ?", id).Scan(&keyID, &revokedAt); err != nil {
+		writeStatusError(w, http.StatusInternalServerError, "Gagal membaca kredensial perangkat")
+		return
+	}
+	if revokedAt.Valid {
+		writeStatusError(w, http.StatusConflict, errDeviceKeyRevoked.Error())
+		return
+	}
+
+	secret, err := randomHex(32)
+	if err != nil {
+		log.Printf("Error generating device key: %v", err)
+		writeStatusError(w, http.StatusInternalServerError, "Gagal membuat kunci perangkat")
+		return
+	}
+	now := time.Now().In(indonesiaLocation)
+	_, err = execDB(r.Context(), "credential_rotate", `
+		UPDATE DeviceCredential
+		SET previousHash = secretHash, previousUntil = ?, secretHash = ?, rotatedAt = ?
+		WHERE id = ?`,
+		now.Add(grace).Format(dbTimeLayout), hashSecret(secret), now.Format(dbTimeLayout), id)
+	if err != nil {
+		log.Printf("Error rotating device credential: %v", err)
+		writeStatusError(w, http.StatusInternalServerError, "Gagal merotasi kredensial perangkat")
+		return
+	}
+	writeJSONResponse(w, http.StatusOK, map[string]string{
+		"key":           keyID + "." + secret,
+		"previousUntil": now.Add(grace).Format(time.RFC3339),
+	})
+}
+
+func revokeDeviceCredential(w http.ResponseWriter, r *http.Request) {
+	id, ok := credentialID(w, r)
+	if !ok {
+		return
+	}
+	_, err := execDB(r.Context(), "credential_revoke", "UPDATE DeviceCredential SET revokedAt = ?, previousHash = NULL, previousUntil = NULL WHERE id = ? AND revokedAt IS NULL",
+		time.Now().In(indonesiaLocation).Format(dbTimeLayout), id)
+	if err != nil {
+		log.Printf("Error revoking device credential: %v", err)
+		writeStatusError(w, http.StatusInternalServerError, "Gagal mencabut kredensial perangkat")
+		return
+	}
+	writeJSONResponse(w, http.StatusOK, APIResponse{Message: "Sukses"})
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// testCredentials migrates an in-memory SQLite database with two parameters
+// and one device key bound to "device-co2", installs it as db and repo, and
+// returns the key and its credential id.
+func testCredentials(t *testing.T) (string, int64) {
+	t.Helper()
+	conn, err := openSQLite("file::memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Setiap koneksi :memory: adalah basis data terpisah
+	conn.SetMaxOpenConns(1)
+	t.Cleanup(func() { conn.Close() })
+
+	if err := migrateSchema(context.Background(), conn, "sqlite"); err != nil {
+		t.Fatalf("migrateSchema: %v", err)
+	}
+	now := time.Now().In(indonesiaLocation).Format(dbTimeLayout)
+	for _, statement := range []string{
+		"INSERT INTO Site (id, name, alias) VALUES ('site-1', 'Ruang Rapat', 'tn_1')",
+		"INSERT INTO Parameter (id, siteId, name, unit, alias) VALUES ('device-co2', 'site-1', 'CO2', 'ppm', 'co2')",
+		"INSERT INTO Parameter (id, siteId, name, unit, alias) VALUES ('device-temp', 'site-1', 'Suhu', 'C', 'temperature')",
+		"INSERT INTO DeviceCredential (id, name, keyId, secretHash, created) VALUES (1, 'sensor', 'key1', '" + hashSecret("rahasia") + "', '" + now + "')",
+		"INSERT INTO DeviceCredentialParameter (credentialId, parameterId) VALUES (1, 'device-co2')",
+	} {
+		if _, err := conn.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldDB, oldRepo := db, repo
+	db, repo = conn, newSQLRepositories(conn, upsertMetadataSQLite)
+	t.Cleanup(func() { db, repo = oldDB, oldRepo })
+	return "key1.rahasia", 1
+}
+
+func TestVerifyDeviceKey(t *testing.T) {
+	key, id := testCredentials(t)
+	ctx := context.Background()
+	now := time.Now().In(indonesiaLocation)
+
+	c, err := verifyDeviceKey(ctx, key, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.ID != id || len(c.ParameterIDs) != 1 || c.ParameterIDs[0] != "device-co2" {
+		t.Errorf("kredensial = %+v, seharusnya terikat ke device-co2", c)
+	}
+
+	for _, presented := range []string{"", "key1", "key1.", ".rahasia", "key1.salah", "key2.rahasia"} {
+		if _, err := verifyDeviceKey(ctx, presented, now); err != errDeviceKeyInvalid {
+			t.Errorf("kunci %q: err = %v, seharusnya %v", presented, err, errDeviceKeyInvalid)
+		}
+	}
+
+	expiry := now.Add(time.Hour).Format(dbTimeLayout)
+	if _, err := db.Exec("UPDATE DeviceCredential SET expiresAt = ? WHERE id = ?", expiry, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifyDeviceKey(ctx, key, now); err != nil {
+		t.Errorf("sebelum kedaluwarsa: err = %v", err)
+	}
+	if _, err := verifyDeviceKey(ctx, key, now.Add(time.Hour)); err != errDeviceKeyExpired {
+		t.Errorf("saat kedaluwarsa: err = %v, seharusnya %v", err, errDeviceKeyExpired)
+	}
+
+	if _, err := db.Exec("UPDATE DeviceCredential SET revokedAt = ? WHERE id = ?", now.Format(dbTimeLayout), id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifyDeviceKey(ctx, key, now); err != errDeviceKeyRevoked {
+		t.Errorf("setelah dicabut: err = %v, seharusnya %v", err, errDeviceKeyRevoked)
+	}
+	// Kunci yang salah tetap dilaporkan tidak valid, bukan dicabut
+	if _, err := verifyDeviceKey(ctx, "key1.salah", now); err != errDeviceKeyInvalid {
+		t.Errorf("kunci salah setelah dicabut: err = %v, seharusnya %v", err, errDeviceKeyInvalid)
+	}
+}
+
+func rotate(t *testing.T, id int64, grace string) string {
+	t.Helper()
+	router := mux.NewRouter()
+	router.HandleFunc("/api/device-credentials/{id}/rotate", rotateDeviceCredential).Methods("POST")
+	recorder := httptest.NewRecorder()
+	target := "/api/device-credentials/" + strconv.FormatInt(id, 10) + "/rotate?grace=" + grace
+	router.ServeHTTP(recorder, httptest.NewRequest("POST", target, nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("rotasi: status = %d: %s", recorder.Code, recorder.Body)
+	}
+	var body map[string]string
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	return body["key"]
+}
+
+func TestRotateDeviceCredentialGrace(t *testing.T) {
+	oldKey, id := testCredentials(t)
+	ctx := context.Background()
+
+	newKey := rotate(t, id, "1h")
+	if newKey == oldKey {
+		t.Fatal("rotasi seharusnya menghasilkan rahasia baru")
+	}
+	now := time.Now().In(indonesiaLocation)
+	if _, err := verifyDeviceKey(ctx, newKey, now); err != nil {
+		t.Errorf("kunci baru: err = %v", err)
+	}
+	if _, err := verifyDeviceKey(ctx, oldKey, now); err != nil {
+		t.Errorf("kunci lama dalam masa tenggang: err = %v", err)
+	}
+	if _, err := verifyDeviceKey(ctx, oldKey, now.Add(2*time.Hour)); err != errDeviceKeyInvalid {
+		t.Errorf("kunci lama setelah masa tenggang: err = %v, seharusnya %v", err, errDeviceKeyInvalid)
+	}
+
+	// grace=0 langsung mengakhiri kunci sebelumnya
+	latest := rotate(t, id, "0s")
+	now = time.Now().In(indonesiaLocation)
+	if _, err := verifyDeviceKey(ctx, newKey, now); err != errDeviceKeyInvalid {
+		t.Errorf("kunci sebelumnya dengan grace=0: err = %v, seharusnya %v", err, errDeviceKeyInvalid)
+	}
+	if _, err := verifyDeviceKey(ctx, latest, now); err != nil {
+		t.Errorf("kunci terbaru: err = %v", err)
+	}
+}
+
+func TestRevokeEndsRotationGrace(t *testing.T) {
+	oldKey, id := testCredentials(t)
+	newKey := rotate(t, id, "1h")
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/device-credentials/{id}/revoke", revokeDeviceCredential).Methods("POST")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest("POST", "/api/device-credentials/"+strconv.FormatInt(id, 10)+"/revoke", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("cabut: status = %d: %s", recorder.Code, recorder.Body)
+	}
+
+	now := time.Now().In(indonesiaLocation)
+	if _, err := verifyDeviceKey(context.Background(), newKey, now); err != errDeviceKeyRevoked {
+		t.Errorf("kunci baru setelah dicabut: err = %v, seharusnya %v", err, errDeviceKeyRevoked)
+	}
+	if _, err := verifyDeviceKey(context.Background(), oldKey, now); err != errDeviceKeyInvalid {
+		t.Errorf("kunci lama setelah dicabut: err = %v, seharusnya %v", err, errDeviceKeyInvalid)
+	}
+}
+
+func TestRequireDeviceParameter(t *testing.T) {
+	key, _ := testCredentials(t)
+	c, err := verifyDeviceKey(context.Background(), key, time.Now().In(indonesiaLocation))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest("POST", "/upload", nil)
+	r = r.WithContext(context.WithValue(r.Context(), deviceCredentialKey{}, c))
+
+	if !requireDeviceParameter(httptest.NewRecorder(), r, "device-co2") {
+		t.Error("kunci seharusnya boleh menulis parameter yang terikat")
+	}
+	recorder := httptest.NewRecorder()
+	if requireDeviceParameter(recorder, r, "device-temp") || recorder.Code != http.StatusForbidden {
+		t.Errorf("parameter lain: status = %d, seharusnya 403", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	if requireDeviceParameter(recorder, httptest.NewRequest("POST", "/upload", nil), "device-co2") || recorder.Code != http.StatusForbidden {
+		t.Errorf("tanpa kunci perangkat: status = %d, seharusnya 403", recorder.Code)
+	}
+}
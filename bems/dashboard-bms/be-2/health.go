@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+/* KODE PROGRAM - KESEHATAN DAN SHUTDOWN */
+
+// readinessTimeout bounds each dependency check of /readyz.
+const readinessTimeout = 2 * time.Second
+
+// shutdownTimeout is how long in-flight uploads may take to finish after
+// SIGTERM before the process exits anyway.
+const shutdownTimeout = 30 * time.Second
+
+// shuttingDown makes /readyz fail as soon as shutdown starts, before the
+// listener is closed.
+var shuttingDown atomic.Bool
+
+type healthCheck struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+func checkResult(err error) healthCheck {
+	if err != nil {
+		return healthCheck{OK: false, Error: err.Error()}
+	}
+	return healthCheck{OK: true}
+}
+
+func checkDatabase(ctx context.Context) error {
+	if db == nil {
+		return errors.New("belum terhubung")
+	}
+	return db.PingContext(ctx)
+}
+
+func checkMinio(ctx context.Context) error {
+	if minioClient == nil {
+		return errors.New("belum terhubung")
+	}
+	exists, err := minioClient.BucketExists(ctx, storage.Bucket)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("bucket %s tidak ditemukan", storage.Bucket)
+	}
+	return nil
+}
+
+// healthHandler answers liveness probes: the process is up and serving.
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSONResponse(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// readyHandler answers 200 only when the database and MinIO are reachable
+// and the service is not shutting down.
+func readyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	checks := map[string]healthCheck{
+		"database": checkResult(checkDatabase(ctx)),
+		"minio":    checkResult(checkMinio(ctx)),
+	}
+	status, code := "ready", http.StatusOK
+	for _, c := range checks {
+		if !c.OK {
+			status, code = "not_ready", http.StatusServiceUnavailable
+		}
+	}
+	if shuttingDown.Load() {
+		status, code = "shutting_down", http.StatusServiceUnavailable
+	}
+	writeJSONResponse(w, code, map[string]interface{}{"status": status, "checks": checks})
+}
+
+// probe performs the container health check: GET url, success on 2xx.
+func probe(url string) error {
+	client := http.Client{Timeout: 2 * readinessTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s menjawab %s", url, resp.Status)
+	}
+	return nil
+}
+
+// shutdown stops accepting requests, waits for in-flight uploads and then
+// closes the database.
+func shutdown(servers ...*http.Server) {
+	shuttingDown.Store(true)
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	for _, server := range servers {
+		if err := server.Shutdown(ctx); err != nil {
+			log.Printf("Gagal menghentikan server HTTP %s dengan rapi: %v", server.Addr, err)
+		}
+	}
+	if traceProvider != nil {
+		if err := traceProvider.Shutdown(ctx); err != nil {
+			log.Printf("Gagal mengirim sisa span tracing: %v", err)
+		}
+	}
+	if db != nil {
+		if err := db.Close(); err != nil {
+			log.Printf("Gagal menutup basis data: %v", err)
+		}
+	}
+	log.Println("Server berhenti")
+	if logFile != nil {
+		logFile.Close()
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import "platform"
+
+/* KODE PROGRAM - LOG TERSTRUKTUR */
+
+// logFileName is the JSON-lines log inside logs.dir.
+const logFileName = "cctb.log"
+
+// logFile is closed on shutdown once everything else has logged.
+var logFile *platform.RotatingFile
+
+// initLogging sends slog, and the standard log package through it, to
+// stderr and the rotating log file as JSON lines.
+func initLogging(cfg config) error {
+	file, err := platform.InitLogging(platform.LogOptions{
+		Dir:        cfg.Logs.Dir,
+		File:       logFileName,
+		Level:      cfg.Logs.Level,
+		MaxSizeMB:  cfg.Logs.MaxSizeMB,
+		MaxAge:     cfg.Logs.MaxAge.Duration,
+		MaxBackups: cfg.Logs.MaxBackups,
+		Service:    auditService,
+	})
+	if err != nil {
+		return err
+	}
+	logFile = file
+	return nil
+}
@@ -2,32 +2,32 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
-	"encoding/csv"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
-	"path/filepath"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql" // Import driver MariaDB/MySQL
 	"github.com/gorilla/mux"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
+
+	"platform"
 )
 
 const (
-	minioEndpoint        = "xxx"
-	minioAccessKey       = "root"
-	minioSecretKey       = "xxx"
-	minioBucketName      = "heb2024"
-	minioFileUploadPath  = "heb2024/model"
-	databaseDSN          = "root:xxx@tcp(xxx)/xxx"
 	allowedFileExtension = ".joblib"
 	validParams          = "temperature,humidity,wind_speed,light_intensity"
 	validLocations       = "indoor,outdoor"
@@ -35,6 +35,7 @@ const (
 
 var (
 	minioClient          *minio.Client
+	storage              minioSettings
 	db                   *sql.DB
 	indonesiaLocation, _ = time.LoadLocation("Asia/Jakarta")
 )
@@ -55,20 +56,25 @@ type APIResponse struct {
 	Message string `json:"message"`
 }
 
-func init() {
+// newMinioClient connects to the object store described by storage.
+func newMinioClient() (*minio.Client, error) {
+	return minio.New(storage.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(storage.AccessKey, storage.SecretKey, ""),
+		Secure: storage.UseSSL,
+	})
+}
+
+func initStorage(cfg config) {
 	// Initialize MinIO Client
 	var err error
-	minioClient, err = minio.New(minioEndpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(minioAccessKey, minioSecretKey, ""),
-		Secure: false,
-	})
+	minioClient, err = newMinioClient()
 	if err != nil {
 		log.Fatalf("Failed to initialize MinIO: %v", err)
 	}
 	log.Println("Berhasil koneksi ke penyimpanan objek")
 
 	// Initialize Database Connection
-	db, err = sql.Open("mysql", databaseDSN)
+	db, repo, err = openRepositories(context.Background(), cfg)
 	if err != nil {
 		log.Fatalf("Failed to connect to the database: %v", err)
 	}
@@ -77,6 +83,7 @@ func init() {
 		log.Fatalf("Database is unreachable: %v", err)
 	}
 	log.Println("Berhasil koneksi ke basis data")
+	registerDBMetrics(db)
 }
 
 func submitSelection(w http.ResponseWriter, r *http.Request) {
@@ -86,17 +93,18 @@ func submitSelection(w http.ResponseWriter, r *http.Request) {
 	parameter := r.FormValue("parameter")
 	model := r.FormValue("model")
 
-	var count int
-	err := db.QueryRow(`
-		SELECT COUNT(*) FROM models_metadata
-		WHERE parameter = ? AND model_name = ? AND siteId = ?
-	`, parameter, model, siteId).Scan(&count)
+	siteAlias, _ := repo.Sites.AliasByID(r.Context(), siteId)
+	if !requireSite(w, r, platform.PermModel, siteAlias) {
+		return
+	}
+
+	exists, err := repo.Models.Exists(r.Context(), siteId, parameter, model)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Error checking metadata: %s", err.Error()), http.StatusInternalServerError)
 		return
 	}
 
-	if count == 0 {
+	if !exists {
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(map[string]string{
 			"error": "Belum ada model yang disimpan untuk parameter maupun lokasi ini.",
@@ -104,12 +112,7 @@ func submitSelection(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = db.Exec(`
-		INSERT INTO models (selected_at, siteId, parameter, model) 
-		VALUES (?, ?, ?, ?)
-	`, time.Now(), siteId, parameter, model)
-
-	if err != nil {
+	if err := repo.Models.Select(r.Context(), siteId, parameter, model, time.Now()); err != nil {
 		http.Error(w, fmt.Sprintf("Error saving data: %s", err.Error()), http.StatusInternalServerError)
 		return
 	}
@@ -142,6 +145,11 @@ func postHandler(w http.ResponseWriter, r *http.Request) {
 	metadata := r.FormValue("metadata")
 	meta_site := r.FormValue("meta_site")
 
+	siteAlias, _ := repo.Sites.AliasByID(r.Context(), siteId)
+	if !requireSite(w, r, platform.PermModel, siteAlias) {
+		return
+	}
+
 	if !strings.HasSuffix(fileHeader.Filename, allowedFileExtension) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusBadRequest)
@@ -149,9 +157,7 @@ func postHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var exists bool
-	query := "SELECT EXISTS(SELECT 1 FROM Site WHERE id = ?);"
-	if err := db.QueryRow(query, siteId).Scan(&exists); err != nil || !exists {
+	if exists, err := repo.Sites.ExistsByID(r.Context(), siteId); err != nil || !exists {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(map[string]string{"error": "Lokasi tidak tersedia"})
@@ -165,9 +171,11 @@ func postHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	objectName := minioFileUploadPath + "/" + fileHeader.Filename
+	objectName := storage.ModelPath + "/" + fileHeader.Filename
 	log.Printf("Mengunggah file ke MinIO: %s", objectName)
-	_, err = minioClient.PutObject(r.Context(), minioBucketName, objectName, file, fileHeader.Size, minio.PutObjectOptions{})
+	done := observeMinio(r.Context(), "put_model")
+	_, err = minioClient.PutObject(r.Context(), storage.Bucket, objectName, file, fileHeader.Size, minio.PutObjectOptions{})
+	done(err)
 	if err != nil {
 		log.Printf("Gagal mengunggah file ke MinIO: %v", err)
 		w.Header().Set("Content-Type", "application/json")
@@ -176,19 +184,14 @@ func postHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	insertQuery := `
-    INSERT INTO models_metadata (metadata, meta_site, model_name, parameter, siteId)
-    VALUES (?, ?, ?, ?, ?)
-    ON DUPLICATE KEY UPDATE
-        metadata = VALUES(metadata),
-        meta_site = VALUES(meta_site),
-        parameter = VALUES(parameter),
-        siteId = VALUES(siteId)
-	`
-
-	log.Printf("Menjalankan SQL: %s", insertQuery)
-
-	if _, err := db.Exec(insertQuery, metadata, meta_site, fileHeader.Filename, parameter, siteId); err != nil {
+	err = repo.Models.Save(r.Context(), modelMetadata{
+		ModelName: fileHeader.Filename,
+		Parameter: parameter,
+		SiteID:    siteId,
+		Metadata:  metadata,
+		MetaSite:  meta_site,
+	})
+	if err != nil {
 		log.Printf("Gagal menyimpan data ke database: %v", err)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusInternalServerError)
@@ -204,17 +207,16 @@ func getImage(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	fileName := vars["fileName"]
 
-	client, err := minio.New(minioEndpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(minioAccessKey, minioSecretKey, ""),
-		Secure: false,
-	})
+	client, err := newMinioClient()
 	if err != nil {
 		http.Error(w, "Failed to connect to MinIO", http.StatusInternalServerError)
 		log.Println("Error: Failed to connect to MinIO")
 		return
 	}
 
-	object, err := client.GetObject(r.Context(), minioBucketName, "gambar/"+fileName, minio.GetObjectOptions{})
+	done := observeMinio(r.Context(), "get_image")
+	object, err := client.GetObject(r.Context(), storage.Bucket, "gambar/"+fileName, minio.GetObjectOptions{})
+	done(err)
 	if err != nil {
 		http.Error(w, "Failed to retrieve file", http.StatusInternalServerError)
 		log.Printf("Error: Failed to retrieve file '%s': %v", fileName, err)
@@ -234,38 +236,11 @@ func getImage(w http.ResponseWriter, r *http.Request) {
 func getActiveModels(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
-	rows, err := db.Query(`
-        SELECT DISTINCT si.alias AS site_alias, m.parameter, m.model_name
-        FROM models_metadata m
-        JOIN Parameter p ON p.alias = m.parameter
-        JOIN Site si ON si.id = m.siteId
-        WHERE si.alias IS NOT NULL;
-    `)
+	siteInfos, err := repo.Models.Active(r.Context())
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Error querying database: %s", err.Error()), http.StatusInternalServerError)
 		return
 	}
-	defer rows.Close()
-
-	var siteInfos []SiteInfo
-	for rows.Next() {
-		var siteAlias, parameter, modelName string
-		if err := rows.Scan(&siteAlias, &parameter, &modelName); err != nil {
-			http.Error(w, fmt.Sprintf("Error reading result: %s", err.Error()), http.StatusInternalServerError)
-			return
-		}
-
-		siteInfos = append(siteInfos, SiteInfo{
-			SiteAlias: siteAlias,
-			Parameter: parameter,
-			Model:     modelName,
-		})
-	}
-
-	if err := rows.Err(); err != nil {
-		http.Error(w, fmt.Sprintf("Error during rows iteration: %s", err.Error()), http.StatusInternalServerError)
-		return
-	}
 
 	if len(siteInfos) == 0 {
 		w.WriteHeader(http.StatusNotFound)
@@ -279,159 +254,72 @@ func getActiveModels(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(siteInfos)
 }
 
-func addLogStep(logData *[]string, step string, prevTime *time.Time) {
-	location, _ := time.LoadLocation("Asia/Jakarta")
-	stepTime := time.Now().In(location)
-	*logData = append(*logData, fmt.Sprintf("Jam: %s. Selisih: %v", stepTime.Format("15:04:05.000000"), stepTime.Sub(*prevTime)))
-	*prevTime = stepTime
-}
-
 // Sementara untuk getHeatmap, hapus s nya kalau butuh
 func getHeatmap(w http.ResponseWriter, r *http.Request) {
-	startTime := time.Now().In(time.FixedZone("Asia/Jakarta", 7*3600))
-	prevTime := startTime
-	logData := []string{}
+	startTime := time.Now()
 
 	vars := mux.Vars(r)
 	parameter := vars["parameter"]
 	roomId := vars["roomId"]
-
-	addLogStep(&logData, "Step 1 - Ambil Parameter URL", &prevTime)
+	logger := slog.With("component", "heatmap", "roomId", roomId, "parameter", parameter)
 
 	prefix := fmt.Sprintf("heatmap/%s_%s", roomId, parameter)
 
-	client, err := minio.New(minioEndpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(minioAccessKey, minioSecretKey, ""),
-		Secure: false,
-	})
+	client, err := newMinioClient()
 	if err != nil {
 		http.Error(w, "Failed to connect to MinIO", http.StatusInternalServerError)
-		log.Println("Error: Failed to connect to MinIO")
-		logData = append(logData, "Error: Gagal koneksi ke MinIO")
-		logHeatmap(roomId, parameter, logData)
+		logger.Error("Gagal koneksi ke MinIO", "error", err)
 		return
 	}
 
-	addLogStep(&logData, "Step 2 - Koneksi MinIO Berhasil", &prevTime)
-
 	opts := minio.ListObjectsOptions{
 		Prefix:    prefix,
 		Recursive: false,
 	}
 
 	// Step 3 - List Objects
-	listStart := time.Now() // Catat waktu sebelum listing objek
+	listStart := time.Now()
 	var lastObject minio.ObjectInfo
 	found := false
-	for objectInfo := range client.ListObjects(r.Context(), minioBucketName, opts) {
+	doneList := observeMinio(r.Context(), "list_heatmap")
+	var listErr error
+	for objectInfo := range client.ListObjects(r.Context(), storage.Bucket, opts) {
 		if objectInfo.Err != nil {
-			log.Printf("Error saat listing objek: %v", objectInfo.Err)
-			logData = append(logData, fmt.Sprintf("Error saat listing objek: %v", objectInfo.Err))
+			listErr = objectInfo.Err
+			logger.Warn("Error saat listing objek", "error", objectInfo.Err)
 			continue
 		}
 		lastObject = objectInfo
 		found = true
 	}
-	// Durasi proses ListObjects
-	listDuration := time.Since(listStart).Seconds() // Durasi dalam detik
-	logData = append(logData, fmt.Sprintf("Step 3 - List Object Selesai: %.10f", listDuration))
+	doneList(listErr)
+	listMs := platform.ElapsedMs(listStart)
 
 	if !found {
 		http.Error(w, "Tidak ada gambar ditemukan", http.StatusNotFound)
-		logData = append(logData, "Gambar tidak ditemukan")
-		logHeatmap(roomId, parameter, logData)
+		logger.Warn("Gambar tidak ditemukan", "listMs", listMs)
 		return
 	}
 
 	// Step 4 - Ambil dan Kirim File
-	step4Start := time.Now() // Catat waktu sebelum pengambilan file
-	object, err := client.GetObject(r.Context(), minioBucketName, lastObject.Key, minio.GetObjectOptions{})
+	doneGet := observeMinio(r.Context(), "get_heatmap")
+	object, err := client.GetObject(r.Context(), storage.Bucket, lastObject.Key, minio.GetObjectOptions{})
+	doneGet(err)
 	if err != nil {
 		http.Error(w, "Gagal mengambil file", http.StatusInternalServerError)
-		logData = append(logData, fmt.Sprintf("Error saat mengambil file: %v", err))
-		logHeatmap(roomId, parameter, logData)
+		logger.Error("Error saat mengambil file", "object", lastObject.Key, "error", err)
 		return
 	}
 	defer object.Close()
 
-	// Durasi pengambilan file
-	step4Duration := time.Since(step4Start).Seconds() // Durasi pengambilan file dalam detik
-	logData = append(logData, fmt.Sprintf("Step 4 - Berhasil ambil objek: %.10f", step4Duration))
-
-	// Mengirim file ke response
-	log.Printf("Berhasil mengambil file terakhir: %s", lastObject.Key)
 	w.Header().Set("Content-Type", "image/png")
-
-	// Waktu mulai mengirim file
-	copyStart := time.Now() // Waktu mulai pengiriman file
 	if _, err := io.Copy(w, object); err != nil {
 		http.Error(w, "Gagal mengirim file", http.StatusInternalServerError)
-		logData = append(logData, fmt.Sprintf("Error saat mengirim file: %v", err))
-		logHeatmap(roomId, parameter, logData)
+		logger.Error("Error saat mengirim file", "object", lastObject.Key, "error", err)
 		return
 	}
 
-	// Durasi pengiriman file
-	copyDuration := time.Since(copyStart).Seconds() // Durasi pengiriman file dalam detik
-	logData = append(logData, fmt.Sprintf("Step 5 - Objek dikirim: %.10f", copyDuration))
-
-	// Total waktu eksekusi
-	logData = append(logData, fmt.Sprintf("Total: %.10f", time.Since(startTime).Seconds()))
-	logHeatmap(roomId, parameter, logData)
-}
-
-var logHeadersHeatmap = []string{
-	"Timestamp",
-	"Room ID",
-	"Parameter",
-	"Step 1 - Ambil URL",
-	"Step 2 - Koneksi MinIO",
-	"Step 3 - List Objek",
-	"Step 4 - Ambil objek",
-	"Step 5 - Kirim objek",
-	"Total Eksekusi",
-}
-
-func logHeatmap(roomId string, parameter string, data []string) {
-	location, _ := time.LoadLocation("Asia/Jakarta")
-	timestamp := time.Now().In(location).Format("2006-01-02 15:04:05.000")
-
-	filePath := "/home/sstk/HEB2024/dashboard-bms/be-2/log-heatmap/log-heatmap.csv"
-	dir := filepath.Dir(filePath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		log.Printf("Gagal membuat direktori log heatmap: %v", err)
-		return
-	}
-
-	fileExists := true
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		fileExists = false
-	}
-
-	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		log.Printf("Gagal membuka file log heatmap: %v", err)
-		return
-	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
-
-	if !fileExists {
-		writer.Write(logHeadersHeatmap)
-	}
-
-	for len(data) < len(logHeadersHeatmap)-3 {
-		data = append(data, "")
-	}
-
-	record := append([]string{timestamp, roomId, parameter}, data...)
-	if err := writer.Write(record); err != nil {
-		log.Printf("Gagal menulis log heatmap ke CSV: %v", err)
-	} else {
-		log.Printf("Berhasil log heatmap: %v", record)
-	}
+	logger.Info("Heatmap dikirim", "object", lastObject.Key, "listMs", listMs, "durationMs", platform.ElapsedMs(startTime))
 }
 
 func uploadImage(w http.ResponseWriter, r *http.Request) {
@@ -458,8 +346,11 @@ func uploadImage(w http.ResponseWriter, r *http.Request) {
 		log.Println("Error: Missing deviceId")
 		return
 	}
+	if !requireDeviceParameter(w, r, deviceID) {
+		return
+	}
 
-	siteAlias, err := getSiteAlias(deviceID)
+	siteAlias, err := repo.Parameters.SiteAlias(r.Context(), deviceID)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Failed to get site alias: %v", err), http.StatusBadRequest)
 		log.Printf("Error: Failed to get site alias for device ID '%s': %v", deviceID, err)
@@ -479,7 +370,9 @@ func uploadImage(w http.ResponseWriter, r *http.Request) {
 	fileNameWithTimestamp := fmt.Sprintf("gambar/%s/%s.%s", siteAlias, timestamp, extension)
 	fileNameWithAlias := fmt.Sprintf("gambar/%s.%s", siteAlias, extension)
 
-	_, err = minioClient.PutObject(r.Context(), minioBucketName, fileNameWithTimestamp, bytes.NewReader(fileData.Bytes()), int64(fileData.Len()), minio.PutObjectOptions{})
+	done := observeMinio(r.Context(), "put_image")
+	_, err = minioClient.PutObject(r.Context(), storage.Bucket, fileNameWithTimestamp, bytes.NewReader(fileData.Bytes()), int64(fileData.Len()), minio.PutObjectOptions{})
+	done(err)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Failed to upload file (timestamp): %v", err), http.StatusInternalServerError)
 		log.Printf("Error: Failed to upload file with timestamp '%s': %v", fileNameWithTimestamp, err)
@@ -487,7 +380,9 @@ func uploadImage(w http.ResponseWriter, r *http.Request) {
 	}
 	log.Printf("Successfully uploaded file with timestamp: %s", fileNameWithTimestamp)
 
-	_, err = minioClient.PutObject(r.Context(), minioBucketName, fileNameWithAlias, bytes.NewReader(fileData.Bytes()), int64(fileData.Len()), minio.PutObjectOptions{})
+	done = observeMinio(r.Context(), "put_image")
+	_, err = minioClient.PutObject(r.Context(), storage.Bucket, fileNameWithAlias, bytes.NewReader(fileData.Bytes()), int64(fileData.Len()), minio.PutObjectOptions{})
+	done(err)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Failed to upload file (alias): %v", err), http.StatusInternalServerError)
 		log.Printf("Error: Failed to upload file with alias '%s': %v", fileNameWithAlias, err)
@@ -500,19 +395,51 @@ func uploadImage(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(APIResponse{Message: "Success"})
 }
 
-func getSiteAlias(deviceID string) (string, error) {
-	var alias string
-	query := `
-		SELECT s.alias FROM Site s JOIN Parameter p ON p.siteId = s.id WHERE p.id = ?;
-	`
-	err := db.QueryRow(query, deviceID).Scan(&alias)
+func main() {
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "berkas konfigurasi YAML (opsional)")
+	healthcheck := flag.String("healthcheck", "", "periksa URL kesehatan (misal http://127.0.0.1:10005/readyz) lalu keluar")
+	migrateOnly := flag.Bool("migrate", false, "terapkan migrasi skema basis data lalu keluar")
+	flag.Parse()
+
+	if *healthcheck != "" {
+		if err := probe(*healthcheck); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	cfg, err := loadConfig(*configFile)
 	if err != nil {
-		return "", err
+		log.Fatalf("Konfigurasi tidak valid:\n%v", err)
+	}
+	if err := initLogging(cfg); err != nil {
+		log.Fatalf("Gagal menyiapkan log: %v", err)
+	}
+	if *migrateOnly {
+		cfg.Database.Migrate = true
+		conn, _, err := openRepositories(context.Background(), cfg)
+		if err != nil {
+			log.Fatalf("Migrasi gagal: %v", err)
+		}
+		conn.Close()
+		return
+	}
+	storage = cfg.MinIO
+	jwtSecret = []byte(cfg.Auth.JWTSecret)
+	if len(jwtSecret) == 0 {
+		log.Println("JWT_SECRET belum diatur, hanya rute publik yang dapat diakses")
+	}
+	if len(cfg.Auth.PublicRoutes) > 0 {
+		publicRoutes, _ = platform.ParsePublicRoutes(strings.Join(cfg.Auth.PublicRoutes, ","))
+	}
+	bootstrapAdmins = parseUserList(strings.Join(cfg.Auth.Admins, ","))
+	trustedProxies, _ = parseTrustedProxies(cfg.HTTP.TrustedProxies)
+
+	initStorage(cfg)
+	if err := initTracing(cfg); err != nil {
+		log.Fatalf("Gagal menyiapkan tracing: %v", err)
 	}
-	return alias, nil
-}
 
-func main() {
 	r := mux.NewRouter()
 
 	r.HandleFunc("/upload", uploadImage).Methods("POST")
@@ -521,14 +448,50 @@ func main() {
 	r.HandleFunc("/monitoring/{fileName}", getImage).Methods("GET")
 	r.HandleFunc("/api/available-models", getActiveModels).Methods("GET")
 	r.HandleFunc("/api/heatmap/{roomId}/{parameter}", getHeatmap).Methods("GET")
+	r.HandleFunc("/api/device-credentials", getDeviceCredentials).Methods("GET")
+	r.HandleFunc("/api/device-credentials", createDeviceCredential).Methods("POST")
+	r.HandleFunc("/api/device-credentials/{id}/parameters", updateCredentialParameters).Methods("PUT")
+	r.HandleFunc("/api/device-credentials/{id}/rotate", rotateDeviceCredential).Methods("POST")
+	r.HandleFunc("/api/device-credentials/{id}/revoke", revokeDeviceCredential).Methods("POST")
+	r.Use(tracingMiddleware)
+	r.Use(metricsMiddleware)
+	r.Use(auditMiddleware)
+	r.Use(authMiddleware)
+	r.Use(rbacMiddleware)
 
 	corsMiddleware := cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://10.46.7.51:10006", "http://localhost:10006", "http://172.35.0.7:10006"},
+		AllowedOrigins:   cfg.HTTP.AllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Content-Type", "Authorization", "X-Requested-With"},
 		AllowCredentials: true,
 	}).Handler(r)
 
-	http.Handle("/", corsMiddleware)
-	log.Fatal(http.ListenAndServe(":10005", nil))
+	mainMux := http.NewServeMux()
+	mainMux.Handle("/", corsMiddleware)
+	mainMux.HandleFunc("/healthz", healthHandler)
+	mainMux.HandleFunc("/readyz", readyHandler)
+
+	log.Printf("Server is running on %s...", cfg.HTTP.Addr)
+	server := &http.Server{Addr: cfg.HTTP.Addr, Handler: mainMux}
+	servers := []*http.Server{server}
+	// Metrik hanya disajikan di port terpisah yang tidak dibuka ke luar jaringan internal
+	if cfg.HTTP.MetricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", promhttp.Handler())
+		servers = append(servers, &http.Server{Addr: cfg.HTTP.MetricsAddr, Handler: metricsMux})
+		log.Printf("Metrics are served on %s/metrics", cfg.HTTP.MetricsAddr)
+	}
+	for _, s := range servers {
+		go func(s *http.Server) {
+			if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatal(err)
+			}
+		}(s)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+	log.Println("Sinyal berhenti diterima, menghentikan server...")
+	shutdown(servers...)
 }
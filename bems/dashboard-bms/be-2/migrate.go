@@ -0,0 +1,16 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+
+	"schema"
+)
+
+/* KODE PROGRAM - MIGRASI SKEMA */
+
+// migrateSchema applies the migrations of bems/dashboard-bms/schema, shared
+// with the other backend; whichever service starts first applies them.
+func migrateSchema(ctx context.Context, conn *sql.DB, driver string) error {
+	return schema.Migrate(ctx, conn, driver, indonesiaLocation)
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"platform"
+)
+
+/* KODE PROGRAM - OTORISASI BERBASIS PERAN */
+
+// routePermissions maps "METHOD /path/template" to the permission it needs;
+// "" only requires a signed-in user. Unlisted GET routes need read, any other
+// unlisted route needs admin.
+var routePermissions = map[string]string{
+	"POST /api/post":      platform.PermModel,
+	"POST /api/selection": platform.PermModel,
+
+	"GET /api/device-credentials":                 platform.PermAdmin,
+	"POST /api/device-credentials":                platform.PermAdmin,
+	"PUT /api/device-credentials/{id}/parameters": platform.PermAdmin,
+	"POST /api/device-credentials/{id}/rotate":    platform.PermAdmin,
+	"POST /api/device-credentials/{id}/revoke":    platform.PermAdmin,
+}
+
+// bootstrapAdmins always hold the admin role on every site, so the first
+// assignments can be made through the API (RBAC_ADMINS).
+var bootstrapAdmins = map[string]bool{}
+
+func loadGrants(ctx context.Context, username string) ([]platform.RoleGrant, error) {
+	grants := []platform.RoleGrant{}
+	if bootstrapAdmins[username] {
+		grants = append(grants, platform.RoleGrant{Username: username, Role: "admin"})
+	}
+
+	rows, err := queryDB(ctx, "user_roles", "SELECT id, username, role, siteAlias, createdBy FROM UserRole WHERE username = ?", username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		g, err := scanGrant(rows)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, g)
+	}
+	return grants, rows.Err()
+}
+
+func scanGrant(rows *sql.Rows) (platform.RoleGrant, error) {
+	var g platform.RoleGrant
+	var siteAlias, createdBy sql.NullString
+	err := rows.Scan(&g.ID, &g.Username, &g.Role, &siteAlias, &createdBy)
+	g.SiteAlias = siteAlias.String
+	g.CreatedBy = createdBy.String
+	return g, err
+}
+
+// allowedSite reports whether the request's user holds perm on a site. An
+// empty site asks for the permission on every site.
+func allowedSite(r *http.Request, perm, site string) bool {
+	return platform.GrantsAllowSite(platform.GrantsFrom(r), perm, site)
+}
+
+// requireSite answers 403 unless the user holds perm on site. Handlers call
+// it when the site comes from the body or a stored record.
+func requireSite(w http.ResponseWriter, r *http.Request, perm, site string) bool {
+	if allowedSite(r, perm, site) {
+		return true
+	}
+	if site == "" {
+		site = "semua lokasi"
+	}
+	writeStatusError(w, http.StatusForbidden, fmt.Sprintf("Tidak memiliki izin %s untuk %s", perm, site))
+	return false
+}
+
+func routePermission(r *http.Request) string {
+	key := platform.RouteKey(r)
+	if perm, ok := routePermissions[key]; ok {
+		return perm
+	}
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return platform.PermRead
+	}
+	return platform.PermAdmin
+}
+
+// rbacMiddleware runs after authMiddleware. Public requests without a user
+// pass through; otherwise the route permission must be held on the site in
+// the path ({siteAlias} or {roomId}), or on any site when the path has none.
+func rbacMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := platform.IdentityFrom(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		grants, err := loadGrants(r.Context(), id.Subject)
+		if err != nil {
+			log.Printf("Gagal memuat peran %s: %v", id.Subject, err)
+			writeStatusError(w, http.StatusInternalServerError, "Gagal memeriksa izin")
+			return
+		}
+		r = r.WithContext(platform.WithGrants(r.Context(), grants))
+
+		if publicRoutes[platform.RouteKey(r)] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		perm := routePermission(r)
+		if perm == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		vars := mux.Vars(r)
+		site := vars["siteAlias"]
+		if site == "" {
+			site = vars["roomId"]
+		}
+
+		// Izin admin hanya berlaku bila diberikan untuk semua lokasi
+		allowed := platform.AllowedAnySite(grants, perm)
+		if site != "" || perm == platform.PermAdmin {
+			allowed = allowedSite(r, perm, site)
+		}
+		if !allowed {
+			writeStatusError(w, http.StatusForbidden, fmt.Sprintf("Tidak memiliki izin %s", perm))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// parseUserList splits a comma-separated list of usernames.
+func parseUserList(s string) map[string]bool {
+	users := map[string]bool{}
+	for _, user := range strings.Split(s, ",") {
+		if user = strings.TrimSpace(user); user != "" {
+			users[user] = true
+		}
+	}
+	return users
+}
+
+func writeStatusError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+/* KODE PROGRAM - AKSES DATA */
+
+// siteRepository reads the Site table.
+type siteRepository interface {
+	// AliasByID returns sql.ErrNoRows when the site does not exist.
+	AliasByID(ctx context.Context, id string) (string, error)
+	ExistsByID(ctx context.Context, id string) (bool, error)
+}
+
+// parameterRepository reads the Parameter table.
+type parameterRepository interface {
+	Exists(ctx context.Context, id string) (bool, error)
+	// SiteAlias returns sql.ErrNoRows when the parameter does not exist.
+	SiteAlias(ctx context.Context, id string) (string, error)
+}
+
+// modelMetadata is one models_metadata row, keyed by the uploaded file name.
+type modelMetadata struct {
+	ModelName string
+	Parameter string
+	SiteID    string
+	Metadata  string
+	MetaSite  string
+}
+
+// modelMetadataRepository stores uploaded soft-sensor models and the model
+// selected per site and parameter (models table, read by be-1).
+type modelMetadataRepository interface {
+	Exists(ctx context.Context, siteId, parameter, model string) (bool, error)
+	Save(ctx context.Context, m modelMetadata) error
+	Select(ctx context.Context, siteId, parameter, model string, at time.Time) error
+	Active(ctx context.Context) ([]SiteInfo, error)
+}
+
+// repositories is the data-access layer used by the handlers. The backend is
+// chosen by database.driver.
+type repositories struct {
+	Sites      siteRepository
+	Parameters parameterRepository
+	Models     modelMetadataRepository
+}
+
+var repo repositories
+
+// upsertMetadataMariaDB replaces the metadata of a model uploaded again.
+const upsertMetadataMariaDB = `
+    INSERT INTO models_metadata (metadata, meta_site, model_name, parameter, siteId)
+    VALUES (?, ?, ?, ?, ?)
+    ON DUPLICATE KEY UPDATE
+        metadata = VALUES(metadata),
+        meta_site = VALUES(meta_site),
+        parameter = VALUES(parameter),
+        siteId = VALUES(siteId)`
+
+// openRepositories connects to the configured database and returns the
+// handle (still used directly by the audit, RBAC and credential tables) with
+// the repositories built on it, after applying pending schema migrations
+// unless database.migrate is off.
+func openRepositories(ctx context.Context, cfg config) (*sql.DB, repositories, error) {
+	var conn *sql.DB
+	var err error
+	upsertMetadata := upsertMetadataMariaDB
+	switch cfg.Database.Driver {
+	case "sqlite":
+		conn, err = openSQLite(cfg.Database.DSN)
+		upsertMetadata = upsertMetadataSQLite
+	default:
+		conn, err = sql.Open("mysql", cfg.Database.DSN)
+	}
+	if err != nil {
+		return nil, repositories{}, err
+	}
+	if cfg.Database.Migrate {
+		if err := migrateSchema(ctx, conn, cfg.Database.Driver); err != nil {
+			conn.Close()
+			return nil, repositories{}, err
+		}
+	}
+	return conn, newSQLRepositories(conn, upsertMetadata), nil
+}
+
+// newSQLRepositories implements every repository with SQL shared by MariaDB
+// and SQLite; only the metadata upsert differs between the two.
+func newSQLRepositories(conn *sql.DB, upsertMetadata string) repositories {
+	return repositories{
+		Sites:      sqlSites{conn},
+		Parameters: sqlParameters{conn},
+		Models:     sqlModels{conn, upsertMetadata},
+	}
+}
+
+type sqlSites struct{ db *sql.DB }
+
+func (s sqlSites) AliasByID(ctx context.Context, id string) (string, error) {
+	var alias string
+	done := observeDB(ctx, "site_alias")
+	err := s.db.QueryRowContext(ctx, "SELECT alias FROM Site WHERE id = ?", id).Scan(&alias)
+	done(err)
+	return alias, err
+}
+
+func (s sqlSites) ExistsByID(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	done := observeDB(ctx, "site_exists")
+	err := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Site WHERE id = ?)", id).Scan(&exists)
+	done(err)
+	return exists, err
+}
+
+type sqlParameters struct{ db *sql.DB }
+
+func (s sqlParameters) Exists(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	done := observeDB(ctx, "parameter_exists")
+	err := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Parameter WHERE id = ?)", id).Scan(&exists)
+	done(err)
+	return exists, err
+}
+
+func (s sqlParameters) SiteAlias(ctx context.Context, id string) (string, error) {
+	var alias string
+	done := observeDB(ctx, "site_alias_by_device")
+	err := s.db.QueryRowContext(ctx, "SELECT s.alias FROM Site s JOIN Parameter p ON p.siteId = s.id WHERE p.id = ?", id).Scan(&alias)
+	done(err)
+	return alias, err
+}
+
+type sqlModels struct {
+	db     *sql.DB
+	upsert string
+}
+
+func (s sqlModels) Exists(ctx context.Context, siteId, parameter, model string) (bool, error) {
+	var count int
+	done := observeDB(ctx, "count_metadata")
+	err := s.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM models_metadata
+		WHERE parameter = ? AND model_name = ? AND siteId = ?
+	`, parameter, model, siteId).Scan(&count)
+	done(err)
+	return count > 0, err
+}
+
+func (s sqlModels) Save(ctx context.Context, m modelMetadata) error {
+	done := observeDB(ctx, "upsert_metadata")
+	_, err := s.db.ExecContext(ctx, s.upsert, m.Metadata, m.MetaSite, m.ModelName, m.Parameter, m.SiteID)
+	done(err)
+	return err
+}
+
+func (s sqlModels) Select(ctx context.Context, siteId, parameter, model string, at time.Time) error {
+	done := observeDB(ctx, "insert_selection")
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO models (selected_at, siteId, parameter, model)
+		VALUES (?, ?, ?, ?)
+	`, at, siteId, parameter, model)
+	done(err)
+	return err
+}
+
+func (s sqlModels) Active(ctx context.Context) ([]SiteInfo, error) {
+	done := observeDB(ctx, "active_models")
+	rows, err := s.db.QueryContext(ctx, `
+        SELECT DISTINCT si.alias AS site_alias, m.parameter, m.model_name
+        FROM models_metadata m
+        JOIN Parameter p ON p.alias = m.parameter
+        JOIN Site si ON si.id = m.siteId
+        WHERE si.alias IS NOT NULL
+    `)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var siteInfos []SiteInfo
+	for rows.Next() {
+		var info SiteInfo
+		if err := rows.Scan(&info.SiteAlias, &info.Parameter, &info.Model); err != nil {
+			return nil, err
+		}
+		siteInfos = append(siteInfos, info)
+	}
+	return siteInfos, rows.Err()
+}
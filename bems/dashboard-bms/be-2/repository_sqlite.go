@@ -0,0 +1,38 @@
+package main
+
+import (
+	"database/sql"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+/* KODE PROGRAM - BACKEND SQLITE */
+
+// sqliteDSN adds the per-connection settings the API relies on: foreign keys
+// like InnoDB, a busy timeout instead of "database is locked" under
+// concurrent requests, and time.Time written in a format the driver reads back.
+func sqliteDSN(dsn string) string {
+	params := []string{"_pragma=foreign_keys(1)", "_pragma=busy_timeout(5000)", "_pragma=journal_mode(WAL)", "_time_format=sqlite"}
+	separator := "?"
+	if strings.Contains(dsn, "?") {
+		separator = "&"
+	}
+	return dsn + separator + strings.Join(params, "&")
+}
+
+// upsertMetadataSQLite is upsertMetadataMariaDB in SQLite syntax.
+const upsertMetadataSQLite = `
+    INSERT INTO models_metadata (metadata, meta_site, model_name, parameter, siteId)
+    VALUES (?, ?, ?, ?, ?)
+    ON CONFLICT (model_name) DO UPDATE SET
+        metadata = excluded.metadata,
+        meta_site = excluded.meta_site,
+        parameter = excluded.parameter,
+        siteId = excluded.siteId`
+
+// openSQLite opens (creating if needed) the SQLite file in dsn, for example
+// "file:bems.db". The tables come from schema/migrations/sqlite.
+func openSQLite(dsn string) (*sql.DB, error) {
+	return sql.Open("sqlite", sqliteDSN(dsn))
+}
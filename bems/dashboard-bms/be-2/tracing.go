@@ -0,0 +1,35 @@
+package main
+
+import (
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"platform"
+)
+
+/* KODE PROGRAM - TRACING OPENTELEMETRY */
+
+// tracer is resolved through the global provider, so spans started before
+// initTracing (or with tracing disabled) are no-ops.
+var tracer = otel.Tracer("cctb")
+
+// traceProvider is flushed on shutdown; nil when tracing is disabled.
+var traceProvider *sdktrace.TracerProvider
+
+// tracingMiddleware starts a server span per routed request, continuing
+// any trace passed in the traceparent header.
+var tracingMiddleware = platform.TracingMiddleware(tracer, clientIP)
+
+// initTracing installs the exporter chosen in cfg.Tracing.
+func initTracing(cfg config) error {
+	provider, err := platform.InitTracing(platform.TraceOptions{
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		SampleRatio: cfg.Tracing.SampleRatio,
+		Service:     "be-2",
+	})
+	if err != nil {
+		return err
+	}
+	traceProvider = provider
+	return nil
+}
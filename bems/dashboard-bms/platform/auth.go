@@ -0,0 +1,190 @@
+// Package platform holds the request authentication, role model, logging and
+// tracing shared by be-1 and be-2.
+package platform
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+/* KODE PROGRAM - AUTENTIKASI JWT */
+
+// Identity is the user behind a request, taken from a be-3 token.
+type Identity struct {
+	Subject   string                 `json:"sub"`
+	ExpiresAt time.Time              `json:"exp"`
+	Claims    map[string]interface{} `json:"-"`
+}
+
+type identityKey struct{}
+
+// IdentityFrom returns the authenticated user of a request, if any.
+func IdentityFrom(r *http.Request) (*Identity, bool) {
+	id, ok := r.Context().Value(identityKey{}).(*Identity)
+	return id, ok
+}
+
+var (
+	ErrTokenMissing   = errors.New("token tidak ditemukan")
+	ErrAuthDisabled   = errors.New("autentikasi belum dikonfigurasi")
+	ErrTokenMalformed = errors.New("token tidak valid")
+	ErrTokenSignature = errors.New("tanda tangan token tidak valid")
+	ErrTokenExpired   = errors.New("token kedaluwarsa")
+)
+
+// JWTLeeway tolerates small clock differences between be-3 and the services.
+const JWTLeeway = 30 * time.Second
+
+// ParsePublicRoutes reads a comma-separated list of "METHOD /path" entries.
+// Mutating methods are rejected so a typo cannot open a write route.
+func ParsePublicRoutes(s string) (map[string]bool, error) {
+	routes := map[string]bool{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		method, path, ok := strings.Cut(entry, " ")
+		if !ok || !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("rute publik tidak valid: %q", entry)
+		}
+		if method != "GET" && method != "HEAD" {
+			return nil, fmt.Errorf("rute publik harus read-only: %q", entry)
+		}
+		routes[method+" "+path] = true
+	}
+	return routes, nil
+}
+
+// ParseToken verifies an HS256 token and its exp, nbf and sub claims.
+func ParseToken(token string, secret []byte, now time.Time) (*Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrTokenMalformed
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, ErrTokenMalformed
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrTokenMalformed
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrTokenSignature
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrTokenMalformed
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, ErrTokenMalformed
+	}
+	expiresAt := time.Unix(int64(exp), 0)
+	if now.After(expiresAt.Add(JWTLeeway)) {
+		return nil, ErrTokenExpired
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(JWTLeeway).Before(time.Unix(int64(nbf), 0)) {
+		return nil, ErrTokenMalformed
+	}
+	sub, ok := claims["sub"].(string)
+	if !ok || sub == "" {
+		return nil, ErrTokenMalformed
+	}
+	return &Identity{Subject: sub, ExpiresAt: expiresAt, Claims: claims}, nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// BearerToken takes the token from the Authorization header. Browsers cannot
+// set headers on WebSocket upgrades, so those may pass ?token= instead.
+func BearerToken(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return r.URL.Query().Get("token")
+	}
+	return ""
+}
+
+// RouteKey is the "METHOD /path/template" of the matched route.
+func RouteKey(r *http.Request) string {
+	path := r.URL.Path
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			path = template
+		}
+	}
+	return r.Method + " " + path
+}
+
+// Auth checks be-3 tokens. Secret is the HS256 key shared with be-3
+// (SECRET_KEY); without it every protected route answers 401. PublicRoutes
+// are RouteKey entries served without a token.
+type Auth struct {
+	Secret       []byte
+	PublicRoutes map[string]bool
+	// Accept runs for every request with a valid token, before the handler.
+	Accept func(r *http.Request, id *Identity)
+	// Reject answers a protected request without a valid token, in the
+	// service's error format.
+	Reject func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// Authenticate verifies the bearer token of a request.
+func (a Auth) Authenticate(r *http.Request) (*Identity, error) {
+	token := BearerToken(r)
+	if token == "" {
+		return nil, ErrTokenMissing
+	}
+	if len(a.Secret) == 0 {
+		return nil, ErrAuthDisabled
+	}
+	return ParseToken(token, a.Secret, time.Now())
+}
+
+// Middleware lets public routes through and requires a valid token on every
+// other route. A valid token on a public route still sets the identity.
+func (a Auth) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := a.Authenticate(r)
+		if err != nil {
+			if a.PublicRoutes[RouteKey(r)] {
+				next.ServeHTTP(w, r)
+				return
+			}
+			a.Reject(w, r, err)
+			return
+		}
+
+		if a.Accept != nil {
+			a.Accept(r, id)
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), identityKey{}, id)))
+	})
+}
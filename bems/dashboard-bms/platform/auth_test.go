@@ -0,0 +1,108 @@
+package platform
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSecret = []byte("rahasia-bersama")
+
+// signToken builds a token from a header and claims, signed with secret.
+func signToken(t *testing.T, header, claims map[string]interface{}, secret []byte) string {
+	t.Helper()
+	segment := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	unsigned := segment(header) + "." + segment(claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseToken(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	hs256 := map[string]interface{}{"alg": "HS256", "typ": "JWT"}
+	claims := func(extra map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{"sub": "budi", "exp": now.Add(time.Hour).Unix()}
+		for k, v := range extra {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+	valid := signToken(t, hs256, claims(nil), testSecret)
+	parts := strings.Split(valid, ".")
+	// Payload dari token lain dengan tanda tangan token yang sah
+	forged := strings.Split(signToken(t, hs256, claims(map[string]interface{}{"sub": "admin"}), testSecret), ".")[1]
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{"valid", valid, nil},
+		{"tanda tangan salah", signToken(t, hs256, claims(nil), []byte("rahasia-lain")), ErrTokenSignature},
+		{"payload diubah", parts[0] + "." + forged + "." + parts[2], ErrTokenSignature},
+		{"alg none", signToken(t, map[string]interface{}{"alg": "none"}, claims(nil), testSecret), ErrTokenMalformed},
+		{"alg HS512", signToken(t, map[string]interface{}{"alg": "HS512"}, claims(nil), testSecret), ErrTokenMalformed},
+		{"tanpa alg", signToken(t, map[string]interface{}{"typ": "JWT"}, claims(nil), testSecret), ErrTokenMalformed},
+		{"kedaluwarsa", signToken(t, hs256, claims(map[string]interface{}{"exp": now.Add(-time.Hour).Unix()}), testSecret), ErrTokenExpired},
+		{"kedaluwarsa melewati leeway", signToken(t, hs256, claims(map[string]interface{}{"exp": now.Add(-JWTLeeway - time.Second).Unix()}), testSecret), ErrTokenExpired},
+		{"kedaluwarsa dalam leeway", signToken(t, hs256, claims(map[string]interface{}{"exp": now.Add(-JWTLeeway + time.Second).Unix()}), testSecret), nil},
+		{"tanpa exp", signToken(t, hs256, claims(map[string]interface{}{"exp": nil}), testSecret), ErrTokenMalformed},
+		{"exp bukan angka", signToken(t, hs256, claims(map[string]interface{}{"exp": "besok"}), testSecret), ErrTokenMalformed},
+		{"nbf sudah lewat", signToken(t, hs256, claims(map[string]interface{}{"nbf": now.Add(-time.Minute).Unix()}), testSecret), nil},
+		{"nbf dalam leeway", signToken(t, hs256, claims(map[string]interface{}{"nbf": now.Add(JWTLeeway - time.Second).Unix()}), testSecret), nil},
+		{"nbf di masa depan", signToken(t, hs256, claims(map[string]interface{}{"nbf": now.Add(JWTLeeway + time.Second).Unix()}), testSecret), ErrTokenMalformed},
+		{"tanpa sub", signToken(t, hs256, claims(map[string]interface{}{"sub": nil}), testSecret), ErrTokenMalformed},
+		{"sub kosong", signToken(t, hs256, claims(map[string]interface{}{"sub": ""}), testSecret), ErrTokenMalformed},
+		{"sub bukan string", signToken(t, hs256, claims(map[string]interface{}{"sub": 42}), testSecret), ErrTokenMalformed},
+		{"kosong", "", ErrTokenMalformed},
+		{"dua segmen", parts[0] + "." + parts[1], ErrTokenMalformed},
+		{"empat segmen", valid + "." + parts[2], ErrTokenMalformed},
+		{"header bukan base64", "!!!." + parts[1] + "." + parts[2], ErrTokenMalformed},
+		{"header bukan JSON", base64.RawURLEncoding.EncodeToString([]byte("bukan json")) + "." + parts[1] + "." + parts[2], ErrTokenMalformed},
+		{"tanda tangan bukan base64", parts[0] + "." + parts[1] + ".!!!", ErrTokenMalformed},
+		{"tanda tangan kosong", parts[0] + "." + parts[1] + ".", ErrTokenSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := ParseToken(tt.token, testSecret, now)
+			if err != tt.err {
+				t.Fatalf("err = %v, seharusnya %v", err, tt.err)
+			}
+			if err == nil && id.Subject != "budi" {
+				t.Errorf("subject = %q, seharusnya budi", id.Subject)
+			}
+		})
+	}
+}
+
+func TestParseTokenIdentity(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	exp := now.Add(time.Hour).Unix()
+	token := signToken(t, map[string]interface{}{"alg": "HS256"}, map[string]interface{}{"sub": "budi", "exp": exp, "name": "Budi"}, testSecret)
+
+	id, err := ParseToken(token, testSecret, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !id.ExpiresAt.Equal(time.Unix(exp, 0)) {
+		t.Errorf("expiresAt = %v, seharusnya %v", id.ExpiresAt, time.Unix(exp, 0))
+	}
+	if id.Claims["name"] != "Budi" {
+		t.Errorf("claims = %v, seharusnya memuat name", id.Claims)
+	}
+}
@@ -0,0 +1,155 @@
+package platform
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+/* KODE PROGRAM - LOG TERSTRUKTUR */
+
+// RotatingFile is an io.Writer that starts a new file when the current one
+// exceeds maxSize bytes or is older than maxAge, keeping maxBackups old
+// files. Writes are serialised, so it is safe for concurrent goroutines.
+type RotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+
+	file   *os.File
+	size   int64
+	opened time.Time
+}
+
+func OpenRotatingFile(path string, maxSize int64, maxAge time.Duration, maxBackups int) (*RotatingFile, error) {
+	f := &RotatingFile{path: path, maxSize: maxSize, maxAge: maxAge, maxBackups: maxBackups}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// open appends to an existing file; its age counts from its mtime.
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file, f.size, f.opened = file, info.Size(), info.ModTime()
+	if info.Size() == 0 {
+		f.opened = time.Now()
+	}
+	return nil
+}
+
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+	if f.size > 0 && (f.size+int64(len(p)) > f.maxSize || time.Since(f.opened) > f.maxAge) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// rotate renames the current file with a timestamp suffix, opens a fresh
+// one and removes the oldest backups.
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	backup := f.path + "." + time.Now().Format("20060102-150405.000")
+	if err := os.Rename(f.path, backup); err != nil {
+		return err
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+
+	backups, err := filepath.Glob(f.path + ".*")
+	if err != nil {
+		return err
+	}
+	sort.Strings(backups)
+	for len(backups) > f.maxBackups {
+		os.Remove(backups[0])
+		backups = backups[1:]
+	}
+	return nil
+}
+
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+func ParseLogLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.ToUpper(s))); err != nil {
+		return level, fmt.Errorf("level log tidak dikenal %q", s)
+	}
+	return level, nil
+}
+
+// LogOptions mirrors the logs section of the service configuration.
+type LogOptions struct {
+	Dir        string
+	File       string
+	Level      string
+	MaxSizeMB  int
+	MaxAge     time.Duration
+	MaxBackups int
+	Service    string
+}
+
+// InitLogging sends slog, and the standard log package through it, to
+// stderr and a rotating file in opts.Dir as JSON lines. The caller closes
+// the returned file on shutdown once everything else has logged.
+func InitLogging(opts LogOptions) (*RotatingFile, error) {
+	level, err := ParseLogLevel(opts.Level)
+	if err != nil {
+		return nil, err
+	}
+	file, err := OpenRotatingFile(filepath.Join(opts.Dir, opts.File), int64(opts.MaxSizeMB)<<20, opts.MaxAge, opts.MaxBackups)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := slog.NewJSONHandler(io.MultiWriter(os.Stderr, file), &slog.HandlerOptions{Level: level})
+	slog.SetDefault(slog.New(handler).With("service", opts.Service))
+	return file, nil
+}
+
+// ElapsedMs is the time since start in milliseconds, for log attributes.
+func ElapsedMs(start time.Time) float64 {
+	return float64(time.Since(start).Microseconds()) / 1000
+}
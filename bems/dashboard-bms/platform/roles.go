@@ -0,0 +1,79 @@
+package platform
+
+import (
+	"context"
+	"net/http"
+)
+
+/* KODE PROGRAM - OTORISASI BERBASIS PERAN */
+
+// Permissions checked by the routes of be-1 and be-2.
+const (
+	PermRead    = "read"
+	PermModel   = "model"
+	PermControl = "control"
+	PermAlert   = "alert"
+	PermAdmin   = "admin"
+)
+
+// RolePermissions is the fixed role model. Assignments in UserRole bind a
+// user to one of these roles, either for one site or for every site.
+var RolePermissions = map[string][]string{
+	"viewer":     {PermRead},
+	"researcher": {PermRead, PermModel},
+	"operator":   {PermRead, PermControl, PermAlert},
+	"admin":      {PermRead, PermModel, PermControl, PermAlert, PermAdmin},
+}
+
+// RoleGrant is one role of a user. An empty SiteAlias covers every site.
+type RoleGrant struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	Role      string `json:"role"`
+	SiteAlias string `json:"siteAlias"`
+	CreatedBy string `json:"createdBy"`
+}
+
+// Has reports whether the grant's role includes perm.
+func (g RoleGrant) Has(perm string) bool {
+	for _, p := range RolePermissions[g.Role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+type grantsKey struct{}
+
+// WithGrants stores the grants of the request's user in ctx.
+func WithGrants(ctx context.Context, grants []RoleGrant) context.Context {
+	return context.WithValue(ctx, grantsKey{}, grants)
+}
+
+// GrantsFrom returns the grants stored by WithGrants, or nil.
+func GrantsFrom(r *http.Request) []RoleGrant {
+	grants, _ := r.Context().Value(grantsKey{}).([]RoleGrant)
+	return grants
+}
+
+// GrantsAllowSite reports whether grants hold perm on a site. An empty site
+// asks for the permission on every site.
+func GrantsAllowSite(grants []RoleGrant, perm, site string) bool {
+	for _, g := range grants {
+		if g.Has(perm) && (g.SiteAlias == "" || g.SiteAlias == site) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedAnySite reports whether grants hold perm on at least one site.
+func AllowedAnySite(grants []RoleGrant, perm string) bool {
+	for _, g := range grants {
+		if g.Has(perm) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package platform
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+/* KODE PROGRAM - TRACING OPENTELEMETRY */
+
+// TraceOptions mirrors the tracing section of the service configuration.
+type TraceOptions struct {
+	Exporter    string
+	Endpoint    string
+	SampleRatio float64
+	Service     string
+}
+
+// InitTracing installs the exporter chosen in opts. The returned provider is
+// flushed on shutdown; it is nil when tracing is disabled, and tracers
+// resolved through the global provider then stay no-ops.
+func InitTracing(opts TraceOptions) (*sdktrace.TracerProvider, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch opts.Exporter {
+	case "stdout":
+		exporter, err = stdouttrace.New()
+	case "otlp":
+		exporter, err = otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(opts.Endpoint))
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", opts.Service))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider, nil
+}
+
+// EndSpan marks the span failed when err is set and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// StatusRecorder remembers the status code written by a handler.
+type StatusRecorder struct {
+	http.ResponseWriter
+	Status int
+}
+
+func (s *StatusRecorder) WriteHeader(status int) {
+	s.Status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// TracingMiddleware starts a server span per routed request, continuing any
+// trace passed in the traceparent header. clientIP resolves the caller with
+// the service's trusted proxies.
+func TracingMiddleware(tracer trace.Tracer, clientIP func(*http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			method, route, _ := strings.Cut(RouteKey(r), " ")
+			ctx, span := tracer.Start(ctx, method+" "+route,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", method),
+					attribute.String("http.route", route),
+					attribute.String("client.address", clientIP(r)),
+				))
+			defer span.End()
+
+			// StatusRecorder tidak mendukung Hijack yang dibutuhkan upgrade WebSocket
+			if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			recorder := &StatusRecorder{ResponseWriter: w, Status: http.StatusOK}
+			next.ServeHTTP(recorder, r.WithContext(ctx))
+
+			span.SetAttributes(attribute.Int("http.response.status_code", recorder.Status))
+			if recorder.Status >= 500 {
+				span.SetStatus(codes.Error, http.StatusText(recorder.Status))
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+-- Skema awal MariaDB: semua tabel yang dipakai be-1, be-2 dan soft-sensor,
+-- termasuk models dan models_metadata yang sebelumnya dibuat manual.
+-- Pernyataan dijalankan satu per satu (DDL MariaDB langsung ter-commit),
+-- jadi semuanya memakai IF NOT EXISTS agar aman diulang, termasuk pada
+-- basis data lama yang tabelnya dibuat dari versi awal init.sql.
+
+CREATE TABLE IF NOT EXISTS `Site` (
+  `id` varchar(36) NOT NULL,
+  `name` varchar(36) NOT NULL,
+  `alias` varchar(36) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `Site_alias_key` (`alias`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `Parameter` (
+  `id` varchar(36) NOT NULL,
+  `siteId` varchar(36) NOT NULL,
+  `name` varchar(36) NOT NULL,
+  `unit` varchar(36) DEFAULT NULL,
+  `alias` varchar(36) NOT NULL,
+  `lastUpdate` datetime(3) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `Parameter_siteId_alias_key` (`siteId`,`alias`),
+  CONSTRAINT `Parameter_siteId_fkey` FOREIGN KEY (`siteId`) REFERENCES `Site` (`id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `Imgcaptured` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `created` datetime(3) NOT NULL,
+  `route` varchar(100) NOT NULL,
+  `deviceId` varchar(191) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `created` (`created`),
+  KEY `deviceId` (`deviceId`),
+  CONSTRAINT `Imgcaptured_deviceId_fkey` FOREIGN KEY (`deviceId`) REFERENCES `Parameter` (`id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `Predict` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `created` datetime(3) NOT NULL,
+  `prediction` varchar(36) NOT NULL,
+  `deviceId` varchar(36) NOT NULL,
+  `synced` varchar(3) NOT NULL DEFAULT 'N',
+  PRIMARY KEY (`id`),
+  KEY `Predict_deviceId_created_at_idx` (`deviceId`,`created`),
+  KEY `Predict_created_at_idx` (`created`),
+  CONSTRAINT `Predict_deviceId_fkey` FOREIGN KEY (`deviceId`) REFERENCES `Parameter` (`id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `Stat` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `created` datetime(3) NOT NULL,
+  `stat` tinyint(1) NOT NULL,
+  `deviceId` varchar(36) NOT NULL,
+  `synced` varchar(3) NOT NULL DEFAULT 'N',
+  PRIMARY KEY (`id`),
+  KEY `Stat_deviceId_created_at_idx` (`deviceId`,`created`),
+  KEY `Stat_created_at_idx` (`created`),
+  CONSTRAINT `Stat_deviceId_fkey` FOREIGN KEY (`deviceId`) REFERENCES `Parameter` (`id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `Value` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `created` datetime(3) NOT NULL,
+  `value` varchar(36) NOT NULL,
+  `deviceId` varchar(36) NOT NULL,
+  `synced` varchar(3) NOT NULL DEFAULT 'N',
+  PRIMARY KEY (`id`),
+  KEY `Value_deviceId_created_at_idx` (`deviceId`,`created`),
+  KEY `Value_created_at_idx` (`created`),
+  CONSTRAINT `Value_deviceId_fkey` FOREIGN KEY (`deviceId`) REFERENCES `Parameter` (`id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `models` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `selected_at` datetime(3) NOT NULL,
+  `siteId` varchar(36) NOT NULL,
+  `parameter` varchar(36) NOT NULL,
+  `model` varchar(191) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `models_siteId_parameter_idx` (`siteId`,`parameter`,`selected_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `models_metadata` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `metadata` text DEFAULT NULL,
+  `meta_site` text DEFAULT NULL,
+  `model_name` varchar(191) NOT NULL,
+  `parameter` varchar(36) NOT NULL,
+  `siteId` varchar(36) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `models_metadata_model_name_key` (`model_name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Tabel model yang sudah dibuat manual sebelum migrasi mungkin belum punya
+-- kunci unik yang dibutuhkan upsert metadata be-2.
+CREATE UNIQUE INDEX IF NOT EXISTS `models_metadata_model_name_key` ON `models_metadata` (`model_name`);
+
+CREATE TABLE IF NOT EXISTS `SoftSensorAccuracy` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `siteId` varchar(36) NOT NULL,
+  `parameter` varchar(36) NOT NULL,
+  `model` varchar(255) NOT NULL,
+  `periodStart` datetime(3) NOT NULL,
+  `periodEnd` datetime(3) NOT NULL,
+  `samples` int(11) NOT NULL,
+  `mae` double NOT NULL,
+  `rmse` double NOT NULL,
+  `bias` double NOT NULL,
+  `r2` double DEFAULT NULL,
+  `created` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `SoftSensorAccuracy_periodStart_idx` (`periodStart`),
+  KEY `SoftSensorAccuracy_siteId_parameter_idx` (`siteId`,`parameter`),
+  CONSTRAINT `SoftSensorAccuracy_siteId_fkey` FOREIGN KEY (`siteId`) REFERENCES `Site` (`id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `AlertRule` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `siteAlias` varchar(36) NOT NULL DEFAULT '*',
+  `parameter` varchar(36) NOT NULL DEFAULT '*',
+  `operator` varchar(2) NOT NULL,
+  `threshold` double NOT NULL,
+  `hysteresis` double NOT NULL DEFAULT 0,
+  `minDuration` int(11) NOT NULL DEFAULT 0,
+  `activeFrom` varchar(5) DEFAULT NULL,
+  `activeTo` varchar(5) DEFAULT NULL,
+  `severity` varchar(16) NOT NULL DEFAULT 'warning',
+  `channels` varchar(255) NOT NULL DEFAULT '',
+  `enabled` tinyint(1) NOT NULL DEFAULT 1,
+  `created` datetime(3) NOT NULL,
+  `updated` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `AlertRule_siteAlias_parameter_idx` (`siteAlias`,`parameter`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `Alert` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `ruleId` int(11) NOT NULL,
+  `ruleName` varchar(100) NOT NULL,
+  `siteAlias` varchar(36) NOT NULL,
+  `parameter` varchar(36) NOT NULL,
+  `deviceId` varchar(36) NOT NULL,
+  `severity` varchar(16) NOT NULL,
+  `status` varchar(16) NOT NULL,
+  `message` varchar(255) NOT NULL,
+  `note` varchar(255) DEFAULT NULL,
+  `value` double NOT NULL,
+  `threshold` double NOT NULL,
+  `triggeredAt` datetime(3) NOT NULL,
+  `acknowledgedAt` datetime(3) DEFAULT NULL,
+  `acknowledgedBy` varchar(100) DEFAULT NULL,
+  `resolvedAt` datetime(3) DEFAULT NULL,
+  `resolvedBy` varchar(100) DEFAULT NULL,
+  `escalationLevel` int(11) NOT NULL DEFAULT 0,
+  `escalatedAt` datetime(3) DEFAULT NULL,
+  `updated` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `Alert_status_siteAlias_idx` (`status`,`siteAlias`),
+  KEY `Alert_ruleId_deviceId_idx` (`ruleId`,`deviceId`),
+  KEY `Alert_triggeredAt_idx` (`triggeredAt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `Schedule` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `siteAlias` varchar(36) NOT NULL,
+  `deviceAlias` varchar(36) NOT NULL,
+  `days` varchar(20) NOT NULL,
+  `time` varchar(5) NOT NULL,
+  `state` varchar(3) DEFAULT NULL,
+  `setpoint` double DEFAULT NULL,
+  `enabled` tinyint(1) NOT NULL DEFAULT 1,
+  `created` datetime(3) NOT NULL,
+  `updated` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `Schedule_siteAlias_deviceAlias_idx` (`siteAlias`,`deviceAlias`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `ScheduleOverride` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `siteAlias` varchar(36) NOT NULL,
+  `deviceAlias` varchar(36) NOT NULL,
+  `runAt` datetime(3) NOT NULL,
+  `until` datetime(3) DEFAULT NULL,
+  `state` varchar(3) DEFAULT NULL,
+  `setpoint` double DEFAULT NULL,
+  `note` varchar(255) DEFAULT NULL,
+  `created` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `ScheduleOverride_runAt_idx` (`runAt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `ScheduleJob` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `scheduleId` int(11) DEFAULT NULL,
+  `overrideId` int(11) DEFAULT NULL,
+  `siteAlias` varchar(36) NOT NULL,
+  `deviceAlias` varchar(36) NOT NULL,
+  `state` varchar(3) DEFAULT NULL,
+  `setpoint` double DEFAULT NULL,
+  `dueAt` datetime(3) NOT NULL,
+  `status` varchar(16) NOT NULL,
+  `executedAt` datetime(3) DEFAULT NULL,
+  `correlationId` varchar(32) DEFAULT NULL,
+  `message` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `ScheduleJob_status_dueAt_idx` (`status`,`dueAt`),
+  KEY `ScheduleJob_scheduleId_dueAt_idx` (`scheduleId`,`dueAt`),
+  KEY `ScheduleJob_overrideId_idx` (`overrideId`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `AutomationRule` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `siteAlias` varchar(36) NOT NULL,
+  `conditions` text NOT NULL,
+  `deviceAlias` varchar(36) NOT NULL,
+  `state` varchar(3) DEFAULT NULL,
+  `setpoint` double DEFAULT NULL,
+  `activeFrom` varchar(5) DEFAULT NULL,
+  `activeTo` varchar(5) DEFAULT NULL,
+  `days` varchar(20) DEFAULT NULL,
+  `cooldown` int(11) NOT NULL DEFAULT 900,
+  `dryRun` tinyint(1) NOT NULL DEFAULT 1,
+  `enabled` tinyint(1) NOT NULL DEFAULT 1,
+  `created` datetime(3) NOT NULL,
+  `updated` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `AutomationLog` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `ruleId` int(11) NOT NULL,
+  `ruleName` varchar(100) NOT NULL,
+  `siteAlias` varchar(36) NOT NULL,
+  `deviceAlias` varchar(36) NOT NULL,
+  `state` varchar(3) DEFAULT NULL,
+  `setpoint` double DEFAULT NULL,
+  `dryRun` tinyint(1) NOT NULL,
+  `outcome` varchar(16) NOT NULL,
+  `correlationId` varchar(32) DEFAULT NULL,
+  `message` varchar(255) DEFAULT NULL,
+  `conditions` text NOT NULL,
+  `created` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `AutomationLog_ruleId_created_idx` (`ruleId`,`created`),
+  KEY `AutomationLog_created_idx` (`created`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `DemandEvent` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `action` varchar(16) NOT NULL,
+  `siteAlias` varchar(36) NOT NULL,
+  `deviceAlias` varchar(36) NOT NULL,
+  `priority` int(11) NOT NULL,
+  `demandW` double NOT NULL,
+  `limitW` double NOT NULL,
+  `correlationId` varchar(32) DEFAULT NULL,
+  `message` varchar(255) DEFAULT NULL,
+  `created` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `DemandEvent_created_idx` (`created`),
+  KEY `DemandEvent_siteAlias_deviceAlias_idx` (`siteAlias`,`deviceAlias`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `AuditLog` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `created` datetime(3) NOT NULL,
+  `service` varchar(16) NOT NULL,
+  `actor` varchar(100) NOT NULL,
+  `action` varchar(191) NOT NULL,
+  `target` text DEFAULT NULL,
+  `params` text DEFAULT NULL,
+  `outcome` varchar(16) NOT NULL,
+  `status` int(11) NOT NULL,
+  `sourceIp` varchar(45) NOT NULL,
+  `durationMs` int(11) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `AuditLog_created_idx` (`created`),
+  KEY `AuditLog_actor_created_idx` (`actor`,`created`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TRIGGER IF NOT EXISTS `AuditLog_no_update` BEFORE UPDATE ON `AuditLog` FOR EACH ROW
+  SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'AuditLog is append-only';
+CREATE TRIGGER IF NOT EXISTS `AuditLog_no_delete` BEFORE DELETE ON `AuditLog` FOR EACH ROW
+  SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'AuditLog is append-only';
+
+CREATE TABLE IF NOT EXISTS `UserRole` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `username` varchar(100) NOT NULL,
+  `role` varchar(16) NOT NULL,
+  `siteAlias` varchar(36) DEFAULT NULL,
+  `createdBy` varchar(100) DEFAULT NULL,
+  `created` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `UserRole_username_idx` (`username`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `DeviceCredential` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `keyId` varchar(16) NOT NULL,
+  `secretHash` char(64) NOT NULL,
+  `previousHash` char(64) DEFAULT NULL,
+  `previousUntil` datetime(3) DEFAULT NULL,
+  `expiresAt` datetime(3) DEFAULT NULL,
+  `revokedAt` datetime(3) DEFAULT NULL,
+  `rotatedAt` datetime(3) DEFAULT NULL,
+  `lastUsedAt` datetime(3) DEFAULT NULL,
+  `created` datetime(3) NOT NULL,
+  `createdBy` varchar(100) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `DeviceCredential_keyId_key` (`keyId`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `DeviceCredentialParameter` (
+  `credentialId` int(11) NOT NULL,
+  `parameterId` varchar(36) NOT NULL,
+  PRIMARY KEY (`credentialId`,`parameterId`),
+  CONSTRAINT `DeviceCredentialParameter_credentialId_fkey` FOREIGN KEY (`credentialId`) REFERENCES `DeviceCredential` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `DeviceCredentialParameter_parameterId_fkey` FOREIGN KEY (`parameterId`) REFERENCES `Parameter` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,19 @@
+-- Value.value adalah varchar(36). numericValue menyimpan bacaan sebagai
+-- double: tulisan baru mengisi kedua kolom dan be-1 -backfill-values mengisi
+-- baris lama per batch. Menambah kolom nullable di akhir tabel bersifat
+-- instan di MariaDB 10.3, jadi aman dijalankan selama ingest berjalan.
+ALTER TABLE `Value` ADD COLUMN IF NOT EXISTS `numericValue` double DEFAULT NULL;
+
+-- Bacaan yang bukan angka. Backfill memindahkan baris Value yang tidak dapat
+-- diurai ke sini; valueId menyimpan id asalnya.
+CREATE TABLE IF NOT EXISTS `ValueText` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `valueId` int(11) DEFAULT NULL,
+  `created` datetime(3) NOT NULL,
+  `value` varchar(255) NOT NULL,
+  `deviceId` varchar(36) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `ValueText_valueId_key` (`valueId`),
+  KEY `ValueText_deviceId_created_idx` (`deviceId`,`created`),
+  CONSTRAINT `ValueText_deviceId_fkey` FOREIGN KEY (`deviceId`) REFERENCES `Parameter` (`id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
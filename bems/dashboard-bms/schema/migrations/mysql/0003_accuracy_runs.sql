@@ -0,0 +1,13 @@
+-- Satu baris per hari yang sudah dievaluasi job akurasi be-1, termasuk hari
+-- tanpa prediksi yang tidak menghasilkan baris SoftSensorAccuracy. Tanpa
+-- penanda ini hari kosong dievaluasi ulang setiap jam.
+CREATE TABLE IF NOT EXISTS `SoftSensorAccuracyRun` (
+  `periodStart` datetime(3) NOT NULL,
+  `periodEnd` datetime(3) NOT NULL,
+  `models` int(11) NOT NULL,
+  `created` datetime(3) NOT NULL,
+  PRIMARY KEY (`periodStart`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT IGNORE INTO `SoftSensorAccuracyRun` (`periodStart`, `periodEnd`, `models`, `created`)
+SELECT `periodStart`, MAX(`periodEnd`), COUNT(*), MAX(`created`) FROM `SoftSensorAccuracy` GROUP BY `periodStart`;
@@ -0,0 +1,292 @@
+-- Skema awal SQLite untuk pengembangan lokal (database.driver: sqlite),
+-- padanan migrations/mysql/0001_baseline.sql. Dijalankan dalam satu
+-- transaksi. Tipe DATETIME membuat driver mengembalikan time.Time seperti
+-- MariaDB.
+
+CREATE TABLE IF NOT EXISTS Site (
+  id TEXT NOT NULL PRIMARY KEY,
+  name TEXT NOT NULL,
+  alias TEXT UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS Parameter (
+  id TEXT NOT NULL PRIMARY KEY,
+  siteId TEXT NOT NULL REFERENCES Site (id) ON DELETE CASCADE ON UPDATE CASCADE,
+  name TEXT NOT NULL,
+  unit TEXT,
+  alias TEXT NOT NULL,
+  lastUpdate DATETIME,
+  UNIQUE (siteId, alias)
+);
+
+CREATE TABLE IF NOT EXISTS Imgcaptured (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created DATETIME NOT NULL,
+  route TEXT NOT NULL,
+  deviceId TEXT NOT NULL REFERENCES Parameter (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+CREATE INDEX IF NOT EXISTS Imgcaptured_created_idx ON Imgcaptured (created);
+CREATE INDEX IF NOT EXISTS Imgcaptured_deviceId_idx ON Imgcaptured (deviceId);
+
+CREATE TABLE IF NOT EXISTS Predict (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created DATETIME NOT NULL,
+  prediction TEXT NOT NULL,
+  deviceId TEXT NOT NULL REFERENCES Parameter (id) ON DELETE CASCADE ON UPDATE CASCADE,
+  synced TEXT NOT NULL DEFAULT 'N'
+);
+CREATE INDEX IF NOT EXISTS Predict_deviceId_created_at_idx ON Predict (deviceId, created);
+CREATE INDEX IF NOT EXISTS Predict_created_at_idx ON Predict (created);
+
+CREATE TABLE IF NOT EXISTS Stat (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created DATETIME NOT NULL,
+  stat INTEGER NOT NULL,
+  deviceId TEXT NOT NULL REFERENCES Parameter (id) ON DELETE CASCADE ON UPDATE CASCADE,
+  synced TEXT NOT NULL DEFAULT 'N'
+);
+CREATE INDEX IF NOT EXISTS Stat_deviceId_created_at_idx ON Stat (deviceId, created);
+CREATE INDEX IF NOT EXISTS Stat_created_at_idx ON Stat (created);
+
+CREATE TABLE IF NOT EXISTS Value (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created DATETIME NOT NULL,
+  value TEXT NOT NULL,
+  deviceId TEXT NOT NULL REFERENCES Parameter (id) ON DELETE CASCADE ON UPDATE CASCADE,
+  synced TEXT NOT NULL DEFAULT 'N'
+);
+CREATE INDEX IF NOT EXISTS Value_deviceId_created_at_idx ON Value (deviceId, created);
+CREATE INDEX IF NOT EXISTS Value_created_at_idx ON Value (created);
+
+CREATE TABLE IF NOT EXISTS models (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  selected_at DATETIME NOT NULL,
+  siteId TEXT NOT NULL,
+  parameter TEXT NOT NULL,
+  model TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS models_siteId_parameter_idx ON models (siteId, parameter, selected_at);
+
+CREATE TABLE IF NOT EXISTS models_metadata (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  metadata TEXT,
+  meta_site TEXT,
+  model_name TEXT NOT NULL UNIQUE,
+  parameter TEXT NOT NULL,
+  siteId TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS SoftSensorAccuracy (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  siteId TEXT NOT NULL REFERENCES Site (id) ON DELETE CASCADE ON UPDATE CASCADE,
+  parameter TEXT NOT NULL,
+  model TEXT NOT NULL,
+  periodStart DATETIME NOT NULL,
+  periodEnd DATETIME NOT NULL,
+  samples INTEGER NOT NULL,
+  mae REAL NOT NULL,
+  rmse REAL NOT NULL,
+  bias REAL NOT NULL,
+  r2 REAL,
+  created DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS SoftSensorAccuracy_periodStart_idx ON SoftSensorAccuracy (periodStart);
+CREATE INDEX IF NOT EXISTS SoftSensorAccuracy_siteId_parameter_idx ON SoftSensorAccuracy (siteId, parameter);
+
+CREATE TABLE IF NOT EXISTS AlertRule (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  name TEXT NOT NULL,
+  siteAlias TEXT NOT NULL DEFAULT '*',
+  parameter TEXT NOT NULL DEFAULT '*',
+  operator TEXT NOT NULL,
+  threshold REAL NOT NULL,
+  hysteresis REAL NOT NULL DEFAULT 0,
+  minDuration INTEGER NOT NULL DEFAULT 0,
+  activeFrom TEXT,
+  activeTo TEXT,
+  severity TEXT NOT NULL DEFAULT 'warning',
+  channels TEXT NOT NULL DEFAULT '',
+  enabled INTEGER NOT NULL DEFAULT 1,
+  created DATETIME NOT NULL,
+  updated DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS AlertRule_siteAlias_parameter_idx ON AlertRule (siteAlias, parameter);
+
+CREATE TABLE IF NOT EXISTS Alert (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  ruleId INTEGER NOT NULL,
+  ruleName TEXT NOT NULL,
+  siteAlias TEXT NOT NULL,
+  parameter TEXT NOT NULL,
+  deviceId TEXT NOT NULL,
+  severity TEXT NOT NULL,
+  status TEXT NOT NULL,
+  message TEXT NOT NULL,
+  note TEXT,
+  value REAL NOT NULL,
+  threshold REAL NOT NULL,
+  triggeredAt DATETIME NOT NULL,
+  acknowledgedAt DATETIME,
+  acknowledgedBy TEXT,
+  resolvedAt DATETIME,
+  resolvedBy TEXT,
+  escalationLevel INTEGER NOT NULL DEFAULT 0,
+  escalatedAt DATETIME,
+  updated DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS Alert_status_siteAlias_idx ON Alert (status, siteAlias);
+CREATE INDEX IF NOT EXISTS Alert_ruleId_deviceId_idx ON Alert (ruleId, deviceId);
+CREATE INDEX IF NOT EXISTS Alert_triggeredAt_idx ON Alert (triggeredAt);
+
+CREATE TABLE IF NOT EXISTS Schedule (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  name TEXT NOT NULL,
+  siteAlias TEXT NOT NULL,
+  deviceAlias TEXT NOT NULL,
+  days TEXT NOT NULL,
+  time TEXT NOT NULL,
+  state TEXT,
+  setpoint REAL,
+  enabled INTEGER NOT NULL DEFAULT 1,
+  created DATETIME NOT NULL,
+  updated DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS Schedule_siteAlias_deviceAlias_idx ON Schedule (siteAlias, deviceAlias);
+
+CREATE TABLE IF NOT EXISTS ScheduleOverride (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  siteAlias TEXT NOT NULL,
+  deviceAlias TEXT NOT NULL,
+  runAt DATETIME NOT NULL,
+  until DATETIME,
+  state TEXT,
+  setpoint REAL,
+  note TEXT,
+  created DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS ScheduleOverride_runAt_idx ON ScheduleOverride (runAt);
+
+CREATE TABLE IF NOT EXISTS ScheduleJob (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  scheduleId INTEGER,
+  overrideId INTEGER,
+  siteAlias TEXT NOT NULL,
+  deviceAlias TEXT NOT NULL,
+  state TEXT,
+  setpoint REAL,
+  dueAt DATETIME NOT NULL,
+  status TEXT NOT NULL,
+  executedAt DATETIME,
+  correlationId TEXT,
+  message TEXT
+);
+CREATE INDEX IF NOT EXISTS ScheduleJob_status_dueAt_idx ON ScheduleJob (status, dueAt);
+CREATE INDEX IF NOT EXISTS ScheduleJob_scheduleId_dueAt_idx ON ScheduleJob (scheduleId, dueAt);
+CREATE INDEX IF NOT EXISTS ScheduleJob_overrideId_idx ON ScheduleJob (overrideId);
+
+CREATE TABLE IF NOT EXISTS AutomationRule (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  name TEXT NOT NULL,
+  siteAlias TEXT NOT NULL,
+  conditions TEXT NOT NULL,
+  deviceAlias TEXT NOT NULL,
+  state TEXT,
+  setpoint REAL,
+  activeFrom TEXT,
+  activeTo TEXT,
+  days TEXT,
+  cooldown INTEGER NOT NULL DEFAULT 900,
+  dryRun INTEGER NOT NULL DEFAULT 1,
+  enabled INTEGER NOT NULL DEFAULT 1,
+  created DATETIME NOT NULL,
+  updated DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS AutomationLog (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  ruleId INTEGER NOT NULL,
+  ruleName TEXT NOT NULL,
+  siteAlias TEXT NOT NULL,
+  deviceAlias TEXT NOT NULL,
+  state TEXT,
+  setpoint REAL,
+  dryRun INTEGER NOT NULL,
+  outcome TEXT NOT NULL,
+  correlationId TEXT,
+  message TEXT,
+  conditions TEXT NOT NULL,
+  created DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS AutomationLog_ruleId_created_idx ON AutomationLog (ruleId, created);
+CREATE INDEX IF NOT EXISTS AutomationLog_created_idx ON AutomationLog (created);
+
+CREATE TABLE IF NOT EXISTS DemandEvent (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  action TEXT NOT NULL,
+  siteAlias TEXT NOT NULL,
+  deviceAlias TEXT NOT NULL,
+  priority INTEGER NOT NULL,
+  demandW REAL NOT NULL,
+  limitW REAL NOT NULL,
+  correlationId TEXT,
+  message TEXT,
+  created DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS DemandEvent_created_idx ON DemandEvent (created);
+CREATE INDEX IF NOT EXISTS DemandEvent_siteAlias_deviceAlias_idx ON DemandEvent (siteAlias, deviceAlias);
+
+CREATE TABLE IF NOT EXISTS AuditLog (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created DATETIME NOT NULL,
+  service TEXT NOT NULL,
+  actor TEXT NOT NULL,
+  action TEXT NOT NULL,
+  target TEXT,
+  params TEXT,
+  outcome TEXT NOT NULL,
+  status INTEGER NOT NULL,
+  sourceIp TEXT NOT NULL,
+  durationMs INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS AuditLog_created_idx ON AuditLog (created);
+CREATE INDEX IF NOT EXISTS AuditLog_actor_created_idx ON AuditLog (actor, created);
+
+CREATE TRIGGER IF NOT EXISTS AuditLog_no_update BEFORE UPDATE ON AuditLog
+BEGIN
+  SELECT RAISE(ABORT, 'AuditLog is append-only');
+END;
+CREATE TRIGGER IF NOT EXISTS AuditLog_no_delete BEFORE DELETE ON AuditLog
+BEGIN
+  SELECT RAISE(ABORT, 'AuditLog is append-only');
+END;
+
+CREATE TABLE IF NOT EXISTS UserRole (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  username TEXT NOT NULL,
+  role TEXT NOT NULL,
+  siteAlias TEXT,
+  createdBy TEXT,
+  created DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS UserRole_username_idx ON UserRole (username);
+
+CREATE TABLE IF NOT EXISTS DeviceCredential (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  name TEXT NOT NULL,
+  keyId TEXT NOT NULL UNIQUE,
+  secretHash TEXT NOT NULL,
+  previousHash TEXT,
+  previousUntil DATETIME,
+  expiresAt DATETIME,
+  revokedAt DATETIME,
+  rotatedAt DATETIME,
+  lastUsedAt DATETIME,
+  created DATETIME NOT NULL,
+  createdBy TEXT
+);
+
+CREATE TABLE IF NOT EXISTS DeviceCredentialParameter (
+  credentialId INTEGER NOT NULL REFERENCES DeviceCredential (id) ON DELETE CASCADE,
+  parameterId TEXT NOT NULL REFERENCES Parameter (id) ON DELETE CASCADE,
+  PRIMARY KEY (credentialId, parameterId)
+);
@@ -0,0 +1,12 @@
+-- Padanan migrations/mysql/0002_value_numeric.sql: salinan bertipe dari
+-- Value.value dan tabel untuk bacaan yang bukan angka.
+ALTER TABLE Value ADD COLUMN numericValue REAL;
+
+CREATE TABLE IF NOT EXISTS ValueText (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  valueId INTEGER UNIQUE,
+  created DATETIME NOT NULL,
+  value TEXT NOT NULL,
+  deviceId TEXT NOT NULL REFERENCES Parameter (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+CREATE INDEX IF NOT EXISTS ValueText_deviceId_created_idx ON ValueText (deviceId, created);
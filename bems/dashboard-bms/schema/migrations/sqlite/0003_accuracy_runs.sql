@@ -0,0 +1,11 @@
+-- Padanan migrations/mysql/0003_accuracy_runs.sql: penanda hari yang sudah
+-- dievaluasi job akurasi, termasuk hari tanpa prediksi.
+CREATE TABLE IF NOT EXISTS SoftSensorAccuracyRun (
+  periodStart DATETIME NOT NULL PRIMARY KEY,
+  periodEnd DATETIME NOT NULL,
+  models INTEGER NOT NULL,
+  created DATETIME NOT NULL
+);
+
+INSERT OR IGNORE INTO SoftSensorAccuracyRun (periodStart, periodEnd, models, created)
+SELECT periodStart, MAX(periodEnd), COUNT(*), MAX(created) FROM SoftSensorAccuracy GROUP BY periodStart;
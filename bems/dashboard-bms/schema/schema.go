@@ -0,0 +1,183 @@
+// Package schema holds the database migrations shared by be-1 and be-2 and
+// the runner that applies them.
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/* KODE PROGRAM - MIGRASI SKEMA */
+
+// migrationFiles holds the schema history of each driver as
+// migrations/<driver>/NNNN_description.sql. be-1 and be-2 share one database
+// and both embed this package; whichever starts first applies them.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// appliedLayout formats SchemaMigration.applied like the services write
+// their own datetime(3) columns.
+const appliedLayout = "2006-01-02 15:04:05.000"
+
+// migrationLock names the MariaDB lock that keeps two services from
+// migrating at the same time.
+const migrationLock = "bems_schema_migration"
+
+// migrationLockTimeout bounds the wait for another service's migration.
+const migrationLockTimeout = time.Minute
+
+// schemaMigrationTable records every applied version, per driver.
+var schemaMigrationTable = map[string]string{
+	"mysql": `CREATE TABLE IF NOT EXISTS SchemaMigration (
+		version int(11) NOT NULL,
+		name varchar(191) NOT NULL,
+		applied datetime(3) NOT NULL,
+		PRIMARY KEY (version)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci`,
+	"sqlite": `CREATE TABLE IF NOT EXISTS SchemaMigration (
+		version INTEGER NOT NULL PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied DATETIME NOT NULL
+	)`,
+}
+
+type migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+// loadMigrations reads the migrations of driver in version order. Versions
+// must start at 1 without gaps so a missing file is caught at startup.
+func loadMigrations(driver string) ([]migration, error) {
+	dir := "migrations/" + driver
+	entries, err := fs.ReadDir(migrationFiles, dir)
+	if err != nil {
+		return nil, fmt.Errorf("migrasi untuk driver %s tidak ditemukan", driver)
+	}
+
+	var migrations []migration
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".sql") {
+			continue
+		}
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("nama migrasi tidak valid: %s", name)
+		}
+		if version != len(migrations)+1 {
+			return nil, fmt.Errorf("migrasi %s: versi harus berurutan mulai dari 1", name)
+		}
+		content, err := fs.ReadFile(migrationFiles, dir+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migration{
+			Version: version,
+			Name:    strings.TrimSuffix(name, ".sql"),
+			SQL:     strings.ReplaceAll(string(content), "\r\n", "\n"),
+		})
+	}
+	return migrations, nil
+}
+
+// SplitStatements cuts a script at semicolons ending a line and drops chunks
+// holding only comments, for drivers that execute one statement per call.
+func SplitStatements(script string) []string {
+	var statements []string
+	for _, chunk := range strings.Split(strings.ReplaceAll(script, "\r\n", "\n"), ";\n") {
+		for _, line := range strings.Split(chunk, "\n") {
+			if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "--") {
+				statements = append(statements, chunk)
+				break
+			}
+		}
+	}
+	return statements
+}
+
+// Migrate applies the migrations newer than the version recorded in
+// SchemaMigration, stamping each with the current time in loc. Each migration and its SchemaMigration row share one
+// transaction. That makes SQLite upgrades atomic; MariaDB commits DDL
+// implicitly, so its migrations are written to be rerunnable after a failure
+// halfway through.
+func Migrate(ctx context.Context, conn *sql.DB, driver string, loc *time.Location) error {
+	migrations, err := loadMigrations(driver)
+	if err != nil {
+		return err
+	}
+
+	// The lock is held by the session, so everything runs on one connection.
+	session, err := conn.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	if driver == "mysql" {
+		var locked sql.NullInt64
+		if err := session.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", migrationLock, int(migrationLockTimeout.Seconds())).Scan(&locked); err != nil {
+			return err
+		}
+		if locked.Int64 != 1 {
+			return errors.New("layanan lain sedang menjalankan migrasi")
+		}
+		defer session.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", migrationLock)
+	}
+
+	if _, err := session.ExecContext(ctx, schemaMigrationTable[driver]); err != nil {
+		return err
+	}
+	var current int
+	if err := session.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM SchemaMigration").Scan(&current); err != nil {
+		return err
+	}
+	if current > len(migrations) {
+		log.Printf("Skema basis data versi %d lebih baru dari migrasi layanan ini (%d)", current, len(migrations))
+		return nil
+	}
+
+	for _, m := range migrations[current:] {
+		if err := applyMigration(ctx, session, driver, m, loc); err != nil {
+			return fmt.Errorf("migrasi %s gagal: %v", m.Name, err)
+		}
+		log.Printf("Migrasi %s diterapkan", m.Name)
+	}
+	log.Printf("Skema basis data pada versi %d", len(migrations))
+	return nil
+}
+
+func applyMigration(ctx context.Context, session *sql.Conn, driver string, m migration, loc *time.Location) error {
+	statements := []string{m.SQL}
+	if driver == "mysql" {
+		// go-sql-driver/mysql runs one statement per call without multiStatements
+		statements = SplitStatements(m.SQL)
+	}
+
+	tx, err := session.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO SchemaMigration (version, name, applied) VALUES (?, ?, ?)",
+		m.Version, m.Name, time.Now().In(loc).Format(appliedLayout)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
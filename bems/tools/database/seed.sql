@@ -0,0 +1,14 @@
+-- Data awal (Site, Parameter dan contoh bacaan) untuk basis data baru.
+-- Jalankan setelah migrasi membuat tabel (be-1/be-2 saat start atau
+-- ./main -migrate). INSERT IGNORE membuat skrip aman diulang. Bacaan Value
+-- diisi numericValue-nya oleh ./main -backfill-values di be-1.
+
+INSERT IGNORE INTO `Site` (`id`, `name`, `alias`) VALUES ('0544f27a-2bea-4bd8-b69a-ce52cc08f002','MCB 2','mcb2'),('2f2c12e2-6fd4-4cc5-9b9f-ff28509586d0','Ongrid AC','ongrid_ac'),('3d268b9e-bab9-4a24-828f-11f9b518f1cd','TN V','tn_5'),('3fc643ba-a3d8-4027-8bb4-61bfc1b12618','TN VII','tn_7'),('60fff278-d2ce-4d21-820c-a51140a907f4','Outdoor','outdoor'),('6402a230-2e7a-49b4-ba33-af8a81bf1f83','TN I','tn_1'),('6b504819-5d65-49fa-9f02-215b96b7c2e0','Bayu 1','bayu1'),('8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','TN III','tn_3'),('9de4db33-0497-4cb5-a669-6d761f93363e','Ongrid DC','ongrid_dc'),('b107d460-7eb0-436c-97a3-08de7fd71528','TN II','tn_2'),('b40d14e0-3020-485a-b967-3067248a5fe0','MCB 1','mcb1'),('bbefb4e6-7b52-4434-803b-d6178523bba8','TN VI','tn_6'),('e4206d87-7d82-4e14-bf4a-96744702911c','Indoor 8','indoor8'),('f9952474-14d1-4b95-9a42-16cbf848e9b2','TN IV','tn_4'),('fa1c1037-8a96-4215-b034-c2a32c56a7d6','SSTK','sstk');
+
+INSERT IGNORE INTO `Parameter` (`id`, `siteId`, `name`, `unit`, `alias`, `lastUpdate`) VALUES ('000577bc-04e1-4f70-926b-d03c070d5655','e4206d87-7d82-4e14-bf4a-96744702911c','Lamp',NULL,'lamp','2024-09-26 07:43:10.175'),('055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','0544f27a-2bea-4bd8-b69a-ce52cc08f002','Power','Watt','power','2024-11-08 12:57:52.159'),('0a0661b8-f87d-4fad-81c4-8d35bbbecaa8','e4206d87-7d82-4e14-bf4a-96744702911c','Light Intensity','lux','light_intensity',NULL),('0a6766e1-0cad-4cb1-9ed3-081e6eb01f7e','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','Wind Speed','m/s','wind_speed','2024-11-08 12:58:08.524'),('0bec1e99-e7e7-4ed1-aed8-d8184bed68ff','9de4db33-0497-4cb5-a669-6d761f93363e','DC KwH','KwH','pv_dc_kwh',NULL),('0c031d4b-6c96-482f-a580-36cc54097694','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Image','jpeg','camera_capture',NULL),('0c9e298d-f33a-4c28-ab66-70c353d01316','60fff278-d2ce-4d21-820c-a51140a907f4','Humidity','%','humidity','2024-09-26 07:43:10.175'),('0ebe3299-26bf-4b9a-8172-4d41a9fa7465','3d268b9e-bab9-4a24-828f-11f9b518f1cd','Humidity','%','humidity',NULL),('106e2b52-4e2a-4b45-9614-5e710d4db639','60fff278-d2ce-4d21-820c-a51140a907f4','UV Type','--','uv_type','2023-04-12 08:06:40.771'),('131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','0544f27a-2bea-4bd8-b69a-ce52cc08f002','Current','Ampere','current','2024-11-08 12:57:52.159'),('135d120b-2fcd-4e1b-8881-155d754884a1','6402a230-2e7a-49b4-ba33-af8a81bf1f83','PM 2.5','ug/m3','pm25','2022-11-29 07:56:51.512'),('13def9cb-d805-46eb-ac04-a023024099b8','60fff278-d2ce-4d21-820c-a51140a907f4','Wind Speed','m/s','wind_speed','2024-07-15 01:50:36.607'),('150024fa-a7df-4e17-ac17-c2d60f0e7428','b40d14e0-3020-485a-b967-3067248a5fe0','Fan',NULL,'fan','2024-09-19 12:53:26.850'),('16abdb54-f2a8-46ca-b0b4-b67123251380','3d268b9e-bab9-4a24-828f-11f9b518f1cd','Fan',NULL,'fan','2024-09-19 12:53:26.803'),('17cbad39-487a-464f-824b-6bd32106b43f','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Ac',NULL,'ac','2024-09-19 12:53:26.768'),('183bc2b7-7c82-4d90-9910-858a673c9007','bbefb4e6-7b52-4434-803b-d6178523bba8','PM 2.5','ug/m3','pm25',NULL),('193c7204-dd95-425d-9a38-8858de9e74c0','3d268b9e-bab9-4a24-828f-11f9b518f1cd','Lamp',NULL,'lamp','2024-09-19 12:53:26.792'),('1ba601be-99d2-4b02-8c11-46cda878ab9d','60fff278-d2ce-4d21-820c-a51140a907f4','Light Intensity','lux','light_intensity','2024-11-08 12:57:52.159'),('1c78552d-716c-47b9-8e25-5058c097257b','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','Fan',NULL,'fan','2024-09-19 12:53:27.070'),('1fd43a9f-8287-4ea4-8ab6-ccb6257fddc4','0544f27a-2bea-4bd8-b69a-ce52cc08f002','KwH','KwH','kwh','2024-11-08 12:57:52.159'),('200ac9bd-600f-4c29-a5d9-c668e0930ac0','6402a230-2e7a-49b4-ba33-af8a81bf1f83','Fan',NULL,'fan','2024-09-19 12:53:26.956'),('2381a743-6a80-45fe-97de-55708ee813d1','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','Humidity','%','humidity',NULL),('298a843b-2e85-4950-946c-a2e0a5627dc5','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','Ac',NULL,'ac','2024-09-19 12:53:27.082'),('29de0f5c-6239-49d7-b1aa-9036a474a346','3d268b9e-bab9-4a24-828f-11f9b518f1cd','Wind Speed','m/s','wind_speed',NULL),('2ab648a2-67d2-40e9-857e-2abca6a3efd2','60fff278-d2ce-4d21-820c-a51140a907f4','Ac',NULL,'ac','2024-09-19 12:53:26.671'),('301cf7e4-df3b-45e8-9219-970b52a33a25','b40d14e0-3020-485a-b967-3067248a5fe0','Power','Watt','power','2024-11-08 12:57:42.544'),('31575641-b9c7-492c-a05d-1c5ef3520ca0','2f2c12e2-6fd4-4cc5-9b9f-ff28509586d0','AC Voltage','Volt','pv_ac_volt',NULL),('3566f2af-3f29-4c1d-91c5-4cf8aaa716d2','f9952474-14d1-4b95-9a42-16cbf848e9b2','PM 2.5','ug/m3','pm25','2024-10-18 08:41:55.896'),('367ca620-086a-4db1-ba37-c69351f34f2c','b107d460-7eb0-436c-97a3-08de7fd71528','PM 2.5','ug/m3','pm25','2024-11-08 12:58:07.724'),('36a8b13c-9217-43f6-9c9f-234e26c4f4a1','9de4db33-0497-4cb5-a669-6d761f93363e','DC Voltage','Volt','pv_dc_volt',NULL),('36fe1f98-012e-4921-ad6c-afc82ceeb9bf','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Temperature','C','temperature','2024-11-08 12:58:08.417'),('37049c90-d6d8-48a4-b1fa-493874fdc83f','3d268b9e-bab9-4a24-828f-11f9b518f1cd','PM 2.5','ug/m3','pm25',NULL),('37765dde-bdb6-4704-aa3e-ba31581baa9b','bbefb4e6-7b52-4434-803b-d6178523bba8','Lamp',NULL,'lamp','2024-09-19 12:53:27.099'),('3aaf2b45-26f4-4d36-a307-6c569f9326bd','fa1c1037-8a96-4215-b034-c2a32c56a7d6','PM 10','ug/m3','pm10','2024-11-08 12:58:08.417'),('3fecb190-6298-4777-b45a-d9613bab03cc','3d268b9e-bab9-4a24-828f-11f9b518f1cd','Temperature','C','temperature',NULL),('42632b26-8776-436c-87b9-2426f9ceb350','3d268b9e-bab9-4a24-828f-11f9b518f1cd','PM 10','ug/m3','pm10',NULL),('458e1911-7865-4afa-a5c5-3d4caf983307','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','PM 2.5','ug/m3','pm25','2024-11-08 12:58:08.524'),('46f9cd44-ca56-434b-a5d7-088ffbe892d0','b40d14e0-3020-485a-b967-3067248a5fe0','Current','Ampere','current','2024-11-08 12:57:42.544'),('4c5d8d1f-1747-47e0-8f72-e49462d1faca','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','Ac',NULL,'ac','2024-09-19 12:53:26.927'),('4f551916-0b9d-40f0-9104-d250e113d46c','fa1c1037-8a96-4215-b034-c2a32c56a7d6','KwH','KwH','kwh','2024-11-08 12:58:08.417'),('50a8a59a-70ea-4651-bbda-1d971f918670','3d268b9e-bab9-4a24-828f-11f9b518f1cd','Light Intensity','lux','light_intensity',NULL),('53b16580-caf8-4909-bbc4-1f911235c10b','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','PM 2.5','ug/m3','pm25',NULL),('544b8734-5609-42ed-8a03-7222cb65984d','bbefb4e6-7b52-4434-803b-d6178523bba8','Wind Speed','m/s','wind_speed',NULL),('5888ff34-ef85-4fda-9124-08db05efca82','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Wind Speed','m/s','wind_speed','2024-11-08 12:58:08.417'),('5a23e932-3f15-48d6-918b-110c9a2ee621','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','Temperature','C','temperature',NULL),('5b010f1f-0fed-494f-8342-1fcc58779f2e','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','CO2','ppm','co2','2024-11-08 12:58:08.524'),('5cd0f939-e798-46f1-8d85-d79c7e6d36c5','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Current','Ampere','current','2024-11-08 12:58:08.417'),('5e594568-6044-47af-b6f4-b698d6db4d27','b107d460-7eb0-436c-97a3-08de7fd71528','Ac',NULL,'ac','2024-09-19 12:53:26.723'),('5e84d353-ec10-461a-a8ef-b01e8d6bcdef','0544f27a-2bea-4bd8-b69a-ce52cc08f002','Voltage','Volt','voltage','2024-11-08 12:57:52.159'),('5f3fe9bb-bea5-4b16-ab7d-742e4502cf40','0544f27a-2bea-4bd8-b69a-ce52cc08f002','Fan',NULL,'fan','2024-09-19 12:53:27.154'),('623a9ecb-a9db-4d6f-ae35-d83fc13a4390','bbefb4e6-7b52-4434-803b-d6178523bba8','Temperature','C','temperature',NULL),('64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','6402a230-2e7a-49b4-ba33-af8a81bf1f83','Wind Speed','m/s','wind_speed','2022-11-29 07:56:51.512'),('6772d4a9-5824-44f5-873d-12f3b230c3b7','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Voltage','Volt','voltage','2024-11-08 12:58:08.417'),('68d5fa66-391a-4b5b-ae18-33af599dd5af','f9952474-14d1-4b95-9a42-16cbf848e9b2','Lamp',NULL,'lamp','2024-09-19 12:53:27.002'),('69144e59-02de-4d07-845f-18c0d5fe5e17','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','PM 10','ug/m3','pm10',NULL),('6b78a18f-bc6a-43ef-af70-b61a3b3cbbb6','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Humidity','%','humidity','2024-11-08 12:58:08.417'),('6f756b24-00c1-4f78-93fb-67143b319117','3d268b9e-bab9-4a24-828f-11f9b518f1cd','Window',NULL,'window','2024-09-26 07:43:10.175'),('70c51198-d4fa-48f8-a92b-617960be1486','6402a230-2e7a-49b4-ba33-af8a81bf1f83','Lamp',NULL,'lamp','2024-09-19 12:53:26.938'),('70ea23af-9fea-4f31-87fa-5bb76716ea0b','f9952474-14d1-4b95-9a42-16cbf848e9b2','Wind Speed','m/s','wind_speed','2024-10-18 08:41:55.896'),('710d18fa-0a72-46bd-8c03-405a877dca41','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','Light Intensity','lux','light_intensity','2024-11-08 12:58:08.524'),('76188520-306b-4d45-a819-ad8dc1f17964','6402a230-2e7a-49b4-ba33-af8a81bf1f83','Ac',NULL,'ac','2024-09-19 12:53:26.984'),('77a2ead0-6546-463a-b478-57f82ecfdf0e','6402a230-2e7a-49b4-ba33-af8a81bf1f83','PM 10','ug/m3','pm10','2022-11-29 07:56:51.512'),('7a410e31-439e-4abe-ae6d-b1088189a04d','f9952474-14d1-4b95-9a42-16cbf848e9b2','Ac',NULL,'ac','2024-09-19 12:53:27.040'),('7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','6402a230-2e7a-49b4-ba33-af8a81bf1f83','Light Intensity','lux','light_intensity','2022-11-29 07:56:51.512'),('820bfada-05dc-4f98-9966-a35e984219d0','2f2c12e2-6fd4-4cc5-9b9f-ff28509586d0','AC Power','Watt','pv_ac_watt',NULL),('83aed412-4e3b-4da6-8c65-f3002d4cec5b','2f2c12e2-6fd4-4cc5-9b9f-ff28509586d0','Power Factor',NULL,'pf_ac',NULL),('843eb29f-7aa8-4011-af49-ef0b6897e308','e4206d87-7d82-4e14-bf4a-96744702911c','Temperature','C','temperature',NULL),('8489d0c5-07f7-4997-a036-0da4ff235010','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','Temperature','C','temperature','2024-11-08 12:58:08.524'),('880951d3-cbc0-43ff-acf4-f8d14233397e','0544f27a-2bea-4bd8-b69a-ce52cc08f002','Ac',NULL,'ac','2024-09-19 12:53:27.172'),('8afa0908-1e4d-4b37-95c2-c4c244f19dbc','f9952474-14d1-4b95-9a42-16cbf848e9b2','CO2','ppm','co2','2024-10-18 08:41:55.896'),('8bb613b8-c419-471a-b1ef-984b403d6346','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','Light Intensity','lux','light_intensity',NULL),('8f454a43-b0f0-4920-a899-121c86086644','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Fan',NULL,'fan','2024-09-19 12:53:26.748'),('8f454a43-b0f0-4920-a899-b3074d07e381','b107d460-7eb0-436c-97a3-08de7fd71528','Lamp',NULL,'lamp','2024-09-19 12:53:26.686'),('8f7bd270-b6d5-4f02-87bd-41511d52b146','b107d460-7eb0-436c-97a3-08de7fd71528','Light Intensity','lux','light_intensity','2024-11-08 12:58:07.724'),('92540c72-e3d6-48a4-9bef-2d9bbd2a4c7f','6b504819-5d65-49fa-9f02-215b96b7c2e0','Wind KwH','KwH','wind_kwh',NULL),('938d911f-c76c-4430-a391-caf8635f3e34','e4206d87-7d82-4e14-bf4a-96744702911c','Fan',NULL,'fan','2024-09-26 07:43:10.215'),('960b6ee2-534f-4401-9983-ca73bb387705','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','Fan',NULL,'fan','2024-09-19 12:53:26.907'),('96c8179c-6ef9-41bf-96e2-86ae9627991b','b107d460-7eb0-436c-97a3-08de7fd71528','Window',NULL,'window','2024-09-26 07:43:10.175'),('97672d70-5f1b-4c33-a5c6-66d3022bb347','f9952474-14d1-4b95-9a42-16cbf848e9b2','Humidity','%','humidity','2024-10-18 08:41:55.896'),('9a6f2762-a5c9-480e-9fcd-8d8144eda7da','b40d14e0-3020-485a-b967-3067248a5fe0','Voltage','Volt','voltage','2024-11-08 12:57:42.544'),('9b50cd5f-9fb3-46ee-9c06-743e560b125c','bbefb4e6-7b52-4434-803b-d6178523bba8','CO2','ppm','co2',NULL),('9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','bbefb4e6-7b52-4434-803b-d6178523bba8','PM 10','ug/m3','pm10',NULL),('9d443143-e6c3-4530-a85e-2c7f852e9d3e','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','CO2','ppm','co2',NULL),('a3f049ed-0ff2-41a2-aea2-17128ff5491e','b40d14e0-3020-485a-b967-3067248a5fe0','Ac',NULL,'ac','2024-09-19 12:53:26.871'),('a40df744-38e9-4800-a26e-35008b3043d4','f9952474-14d1-4b95-9a42-16cbf848e9b2','Light Intensity','lux','light_intensity','2024-10-18 08:41:55.896'),('a43d406f-4f7d-40b1-940d-eef3de344255','bbefb4e6-7b52-4434-803b-d6178523bba8','Light Intensity','lux','light_intensity',NULL),('a58898c6-1c1b-4d78-87a8-98b45bf63919','b107d460-7eb0-436c-97a3-08de7fd71528','CO2','ppm','co2','2024-11-08 12:58:07.724'),('a8945b61-bee4-463e-abee-87be83c49496','60fff278-d2ce-4d21-820c-a51140a907f4','Fan',NULL,'fan','2024-09-19 12:53:26.661'),('aa7a3c2c-3566-420e-9138-11d780255feb','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','Lamp',NULL,'lamp','2024-09-19 12:53:26.894'),('ab300284-2fb8-488b-b375-94b884b599d3','60fff278-d2ce-4d21-820c-a51140a907f4','UV Class','--','uv_class','2023-04-12 08:06:40.771'),('af341403-6194-4183-b043-c6ab15b7b7c8','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Light Intensity','lux','light_intensity','2024-11-08 12:58:08.417'),('b0af5374-fd1d-4323-a612-2ef556e5560b','e4206d87-7d82-4e14-bf4a-96744702911c','Humidity','%','humidity',NULL),('b190b3e9-0417-4340-9c44-ad91bc5974b8','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Power','Watt','power','2024-11-08 12:58:08.417'),('b411d572-4f94-4a70-8207-fd365c7b9618','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','Wind Speed','m/s','wind_speed',NULL),('b58067cc-2dbb-4ad8-a46a-750ef78a7c41','f9952474-14d1-4b95-9a42-16cbf848e9b2','Window',NULL,'window','2024-09-26 07:43:10.175'),('b613115b-c708-4c67-9e3c-072bf43c2ef4','6b504819-5d65-49fa-9f02-215b96b7c2e0','Wind Power','Watt','wind_power',NULL),('b785c4b1-9cb7-4755-bd3a-985b22dcf762','9de4db33-0497-4cb5-a669-6d761f93363e','DC Power','Watt','pv_dc_watt',NULL),('b8bcd98b-d722-46b6-9098-6acbdfb96142','e4206d87-7d82-4e14-bf4a-96744702911c','Wind Direction','--','wind_direction',NULL),('ba8cfbaf-1d43-457c-9b28-aca33cf02f21','b40d14e0-3020-485a-b967-3067248a5fe0','Lamp',NULL,'lamp','2024-09-19 12:53:26.839'),('bac083e0-904f-4cb1-ab79-34e6cc10bca2','60fff278-d2ce-4d21-820c-a51140a907f4','Lamp',NULL,'lamp','2024-09-19 12:53:26.625'),('bc319248-22e8-4d72-ad9c-86bfacdd92ed','b107d460-7eb0-436c-97a3-08de7fd71528','Temperature','C','temperature','2024-11-08 12:58:07.724'),('bc7b96b4-3520-4317-acb2-d509ee963dfd','60fff278-d2ce-4d21-820c-a51140a907f4','Solar Power','W/m2','solar_power','2024-07-15 01:50:36.607'),('bc839671-1cea-4782-a282-5c95d408cc5a','6402a230-2e7a-49b4-ba33-af8a81bf1f83','Temperature','C','temperature','2022-11-29 07:56:51.512'),('bddb3ab0-3fe7-40eb-8f57-05becbc79833','2f2c12e2-6fd4-4cc5-9b9f-ff28509586d0','AC KwH','KwH','pv_ac_kwh',NULL),('c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','2f2c12e2-6fd4-4cc5-9b9f-ff28509586d0','AC Current','Ampere','pv_ac_ampere',NULL),('c5719c7c-09d8-490d-9b2a-9f97daf14ea8','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Lamp',NULL,'lamp','2024-09-19 12:53:26.735'),('cabd6a44-2798-4120-a5cd-2b0fbce91869','bbefb4e6-7b52-4434-803b-d6178523bba8','Humidity','%','humidity',NULL),('cbb32d4b-f55e-44a0-8d95-5dc6b578ecbc','3d268b9e-bab9-4a24-828f-11f9b518f1cd','CO2','ppm','co2',NULL),('cf3aa532-e0b8-4b23-9f00-a4d71ecaac91','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','Window',NULL,'window','2024-09-26 07:43:10.175'),('cfb66322-561c-418a-bbc1-d761a36ac620','60fff278-d2ce-4d21-820c-a51140a907f4','Temperature','C','temperature','2023-04-12 08:06:40.771'),('cff910b7-1e0e-49a4-b31d-02ece4cf5303','bbefb4e6-7b52-4434-803b-d6178523bba8','Window',NULL,'window','2024-09-26 07:43:10.175'),('d1067783-47e8-46df-95cb-c79b296f4475','f9952474-14d1-4b95-9a42-16cbf848e9b2','Temperature','C','temperature','2024-10-18 08:41:55.896'),('d297705c-edfd-4d3e-afaa-125b7439e968','6402a230-2e7a-49b4-ba33-af8a81bf1f83','Humidity','%','humidity','2022-11-29 07:56:51.512'),('d342f77c-b7ec-4d88-91b3-5ef7170d2d28','fa1c1037-8a96-4215-b034-c2a32c56a7d6','Window',NULL,'window','2024-09-26 07:43:10.175'),('d9b04b21-aa26-4107-b286-88ed88152ed1','8adb5c84-a6d0-4c81-a79c-dc5bee5081e1','Lamp',NULL,'lamp','2024-09-19 12:53:27.053'),('d9b4b762-0ce9-4f56-88f4-ce418cfd5380','fa1c1037-8a96-4215-b034-c2a32c56a7d6','PM 2.5','ug/m3','pm25','2024-11-08 12:58:08.417'),('dadee7fb-1198-4a46-be41-c844f67fd4fd','bbefb4e6-7b52-4434-803b-d6178523bba8','Ac',NULL,'ac','2024-09-19 12:53:27.131'),('dbb35224-a4bc-457d-8ee7-afed6e9cb771','f9952474-14d1-4b95-9a42-16cbf848e9b2','PM 10','ug/m3','pm10','2024-10-18 08:41:55.896'),('dccfc8fa-7b93-4e57-95f0-b935f491ea60','bbefb4e6-7b52-4434-803b-d6178523bba8','Fan',NULL,'fan','2024-09-19 12:53:27.112'),('de9ef69a-e61c-4216-821f-385a72ba6dbf','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','Humidity','%','humidity','2024-11-08 12:58:08.524'),('dfaed957-9075-4e5b-b083-345625251f09','b107d460-7eb0-436c-97a3-08de7fd71528','PM 10','ug/m3','pm10','2024-11-08 12:58:07.724'),('dfb08ea6-7bc3-442b-b676-b97d5a758c11','f9952474-14d1-4b95-9a42-16cbf848e9b2','Fan',NULL,'fan','2024-09-19 12:53:27.027'),('e25642b7-8fe8-4711-9185-f46f5e5fbc31','9de4db33-0497-4cb5-a669-6d761f93363e','DC Current','Ampere','pv_dc_ampere',NULL),('e519548b-fe0c-4a67-904e-5414c77e889e','6402a230-2e7a-49b4-ba33-af8a81bf1f83','Window',NULL,'window','2024-09-26 07:43:10.175'),('e5a20c1b-af7c-438b-8016-ad4694c4081e','b107d460-7eb0-436c-97a3-08de7fd71528','Humidity','%','humidity','2024-11-08 12:58:07.724'),('ec071401-884b-4af7-a86d-9f00369de403','0544f27a-2bea-4bd8-b69a-ce52cc08f002','Lamp',NULL,'lamp','2024-09-19 12:53:27.143'),('ecc30ddf-c78c-4da2-b71f-04405d038890','3d268b9e-bab9-4a24-828f-11f9b518f1cd','Ac',NULL,'ac','2024-09-19 12:53:26.826'),('ee130acf-365d-43b6-8483-8dca088c1158','6b504819-5d65-49fa-9f02-215b96b7c2e0','Wind Voltage','Volt','wind_voltage',NULL),('f0c72926-24a4-4aa0-9e0e-98b5ca56287a','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','Window',NULL,'window','2024-09-26 07:43:10.175'),('f5040f1c-61ed-44cb-9e97-09112d6ae1ca','e4206d87-7d82-4e14-bf4a-96744702911c','Window',NULL,'window','2024-09-26 07:43:10.175'),('f57ed146-ae71-4a60-923d-2c4d6a192870','e4206d87-7d82-4e14-bf4a-96744702911c','Ac',NULL,'ac','2024-09-26 07:43:10.228'),('f5c4c5fb-d913-428f-9507-1caa49667b87','3fc643ba-a3d8-4027-8bb4-61bfc1b12618','PM 10','ug/m3','pm10','2024-11-08 12:58:08.524'),('f70fe243-ebe4-4e57-a407-67539f885e37','6402a230-2e7a-49b4-ba33-af8a81bf1f83','CO2','ppm','co2','2022-11-29 07:56:51.512'),('f8a652e6-d38e-44f1-9332-f262919a1937','b107d460-7eb0-436c-97a3-08de7fd71528','Fan',NULL,'fan','2024-09-19 12:53:26.701'),('f99d3324-c299-4ced-88f8-7f032b7a6805','6b504819-5d65-49fa-9f02-215b96b7c2e0','Wind DC Current','Ampere','wind_ampere',NULL),('f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','b40d14e0-3020-485a-b967-3067248a5fe0','KwH','KwH','kwh','2024-11-08 12:57:42.544'),('fbb174f6-6aa9-4d8f-a3c4-998679c671d2','fa1c1037-8a96-4215-b034-c2a32c56a7d6','CO2','ppm','co2','2024-11-08 12:58:08.417'),('fcd67b20-4b51-4b09-826a-428ae7c9188e','b107d460-7eb0-436c-97a3-08de7fd71528','Wind Speed','m/s','wind_speed','2024-11-08 12:58:07.724');
+
+INSERT IGNORE INTO `Imgcaptured` (`id`, `created`, `route`, `deviceId`) VALUES (22,'2024-12-03 13:26:29.000','indoor2.jpg','0c031d4b-6c96-482f-a580-36cc54097694'),(23,'2024-12-03 13:29:48.000','indoor2.jpg','0c031d4b-6c96-482f-a580-36cc54097694');
+
+INSERT IGNORE INTO `Stat` (`id`, `created`, `stat`, `deviceId`, `synced`) VALUES (1,'2024-09-19 12:51:19.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(2,'2024-09-19 12:51:20.000',0,'8f454a43-b0f0-4920-a899-121c86086644','N'),(3,'2024-09-19 12:56:12.000',0,'8f454a43-b0f0-4920-a899-121c86086644','N'),(4,'2024-09-20 06:34:16.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(5,'2024-09-20 06:35:28.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(6,'2024-09-20 06:35:34.000',1,'150024fa-a7df-4e17-ac17-c2d60f0e7428','N'),(7,'2024-09-20 06:36:16.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(8,'2024-09-20 06:36:17.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(9,'2024-09-20 06:36:17.000',1,'150024fa-a7df-4e17-ac17-c2d60f0e7428','N'),(10,'2024-09-20 06:36:17.000',0,'150024fa-a7df-4e17-ac17-c2d60f0e7428','N'),(11,'2024-09-20 07:07:30.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(12,'2024-09-20 07:52:45.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(13,'2024-09-20 07:52:45.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(18,'2024-11-25 10:56:42.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(19,'2024-11-25 10:56:42.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(20,'2024-11-25 10:56:43.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(21,'2024-11-25 10:56:43.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(22,'2024-11-25 10:56:44.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(23,'2024-11-25 10:56:44.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(24,'2024-11-25 10:56:44.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(25,'2024-11-25 10:58:28.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(26,'2024-11-26 03:45:04.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(27,'2024-11-26 03:45:22.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(30,'2024-11-26 04:53:14.000',0,'8f454a43-b0f0-4920-a899-121c86086644','N'),(32,'2024-11-26 07:17:28.000',1,'8f454a43-b0f0-4920-a899-121c86086644','N'),(33,'2024-11-26 07:20:20.000',0,'8f454a43-b0f0-4920-a899-121c86086644','N'),(34,'2024-11-26 07:22:45.000',0,'8f454a43-b0f0-4920-a899-121c86086644','N'),(35,'2024-11-26 07:24:18.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(36,'2024-11-26 07:25:07.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(37,'2024-11-26 07:25:57.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(38,'2024-11-26 07:34:06.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(39,'2024-11-26 07:34:07.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(40,'2024-11-26 07:39:08.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(41,'2024-11-26 07:39:16.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(42,'2024-11-26 07:39:17.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(43,'2024-11-26 07:46:38.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(44,'2024-11-26 07:46:56.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(45,'2024-11-26 07:47:09.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(46,'2024-11-26 07:56:30.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(52,'2024-11-26 08:10:22.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(53,'2024-11-26 08:10:31.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(54,'2024-11-26 08:10:32.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(55,'2024-11-26 08:10:49.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(56,'2024-11-26 08:10:50.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(57,'2024-11-26 08:11:04.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(58,'2024-11-26 08:11:04.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(59,'2024-11-26 09:02:54.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(60,'2024-11-26 09:05:49.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(61,'2024-11-26 09:20:37.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(62,'2024-11-27 06:31:06.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(63,'2024-11-27 06:47:51.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(64,'2024-11-27 07:02:28.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(65,'2024-11-27 07:03:00.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(66,'2024-11-27 07:03:11.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(67,'2024-11-27 07:08:33.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(68,'2024-11-27 07:08:34.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(69,'2024-11-27 07:08:47.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(70,'2024-11-27 07:08:49.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(71,'2024-11-27 07:10:37.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(72,'2024-11-27 07:22:59.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(73,'2024-11-27 07:23:13.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(74,'2024-11-27 07:23:53.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(75,'2024-11-29 08:20:11.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(76,'2024-11-29 08:34:02.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(77,'2024-11-29 08:58:21.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(78,'2024-11-29 09:13:42.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(79,'2024-11-29 09:14:02.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(80,'2024-11-29 09:15:21.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(81,'2024-11-29 11:44:16.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(82,'2024-12-01 07:56:09.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(83,'2024-12-01 07:58:21.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(84,'2024-12-01 07:58:39.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(85,'2024-12-01 07:58:50.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(86,'2024-12-01 07:58:51.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(87,'2024-12-01 08:23:51.000',1,'7a410e31-439e-4abe-ae6d-b1088189a04d','N'),(88,'2024-12-01 08:24:20.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(89,'2024-12-01 08:58:12.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(90,'2024-12-01 08:58:57.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(91,'2024-12-01 08:58:58.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(92,'2024-12-01 09:00:46.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(93,'2024-12-01 09:00:46.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(94,'2024-12-01 09:32:03.000',0,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(95,'2024-12-01 09:32:59.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(96,'2024-12-01 09:46:18.000',0,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(97,'2024-12-01 10:00:11.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(98,'2024-12-01 10:01:05.000',0,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(99,'2024-12-01 10:22:35.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(100,'2024-12-01 10:22:42.000',0,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(101,'2024-12-01 10:24:41.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(102,'2024-12-01 10:25:16.000',0,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(103,'2024-12-01 17:46:50.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(104,'2024-12-01 17:46:56.000',1,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(105,'2024-12-01 17:47:39.000',0,'68d5fa66-391a-4b5b-ae18-33af599dd5af','N'),(106,'2024-12-01 17:48:12.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(107,'2024-12-01 17:49:09.000',0,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(108,'2024-12-01 17:52:31.000',1,'dfb08ea6-7bc3-442b-b676-b97d5a758c11','N'),(109,'2024-12-04 12:12:16.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(110,'2024-12-04 12:12:16.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(111,'2024-12-04 12:12:17.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(112,'2024-12-04 12:20:25.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(118,'2024-12-04 13:02:05.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(119,'2024-12-04 13:04:15.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(122,'2024-12-04 13:09:50.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(123,'2024-12-04 13:11:07.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(125,'2024-12-04 13:12:06.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(126,'2024-12-04 13:15:46.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(134,'2024-12-04 13:21:22.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(135,'2024-12-04 13:21:22.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(136,'2024-12-04 13:21:23.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(137,'2024-12-04 13:31:57.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(139,'2024-12-05 03:31:41.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(140,'2024-12-05 03:32:35.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(141,'2024-12-05 03:33:08.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(142,'2024-12-05 03:33:13.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(143,'2024-12-05 03:34:03.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(144,'2024-12-05 03:34:51.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(145,'2024-12-05 03:38:45.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(146,'2024-12-05 03:39:53.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(147,'2024-12-05 03:44:03.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(148,'2024-12-05 03:44:05.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(149,'2024-12-05 03:44:30.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(150,'2024-12-05 03:44:31.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(151,'2024-12-05 03:53:35.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(152,'2024-12-05 03:57:27.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(153,'2024-12-05 03:57:29.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(154,'2024-12-05 04:15:20.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(155,'2024-12-05 04:44:33.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(156,'2024-12-05 05:02:03.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(157,'2024-12-05 08:39:09.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(158,'2024-12-05 08:39:10.000',1,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(159,'2024-12-05 08:53:05.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(160,'2024-12-05 09:04:25.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N'),(161,'2024-12-05 09:05:13.000',0,'c5719c7c-09d8-490d-9b2a-9f97daf14ea8','N');
+
+INSERT IGNORE INTO `Value` (`id`, `created`, `value`, `deviceId`, `synced`) VALUES (19,'2024-11-12 08:58:30.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(20,'2024-11-12 08:58:31.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(21,'2024-11-12 08:58:31.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(22,'2024-11-12 08:58:31.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(23,'2024-11-12 08:58:31.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(24,'2024-11-12 08:58:31.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(25,'2024-11-12 09:09:53.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(26,'2024-11-12 09:09:53.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(27,'2024-11-12 09:09:53.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(28,'2024-11-12 09:09:53.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(29,'2024-11-12 09:09:54.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(30,'2024-11-12 09:09:54.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(31,'2024-11-12 09:19:13.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(32,'2024-11-12 09:19:13.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(33,'2024-11-12 09:19:13.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(34,'2024-11-12 09:19:14.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(35,'2024-11-12 09:19:14.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(36,'2024-11-12 09:19:14.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(37,'2024-11-12 09:19:14.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(38,'2024-11-12 09:19:14.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(39,'2024-11-12 09:19:14.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(40,'2024-11-12 09:19:15.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(41,'2024-11-12 09:19:15.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(42,'2024-11-12 09:19:15.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(43,'2024-11-12 09:19:15.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(44,'2024-11-12 09:19:15.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(45,'2024-11-12 09:19:15.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(46,'2024-11-12 09:19:16.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(47,'2024-11-12 09:19:16.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(48,'2024-11-12 09:19:16.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(49,'2024-11-12 11:01:06.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(50,'2024-11-12 11:01:07.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(51,'2024-11-12 11:01:07.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(52,'2024-11-12 11:01:07.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(53,'2024-11-12 11:01:07.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(54,'2024-11-12 11:01:07.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(55,'2024-11-12 11:01:08.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(56,'2024-11-12 11:01:08.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(57,'2024-11-12 11:01:08.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(58,'2024-11-12 11:01:08.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(59,'2024-11-12 11:01:08.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(60,'2024-11-12 11:01:09.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(61,'2024-11-12 11:01:09.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(62,'2024-11-12 11:01:09.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(63,'2024-11-12 11:01:09.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(64,'2024-11-12 11:01:09.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(65,'2024-11-12 11:01:09.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(66,'2024-11-12 11:01:10.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(67,'2024-11-12 11:04:14.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(68,'2024-11-12 11:04:14.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(69,'2024-11-12 11:04:14.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(70,'2024-11-12 11:04:14.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(71,'2024-11-12 11:04:14.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(72,'2024-11-12 11:04:15.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(73,'2024-11-12 11:04:15.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','Y'),(74,'2024-11-12 11:04:15.000','2','544b8734-5609-42ed-8a03-7222cb65984d','Y'),(75,'2024-11-12 11:04:15.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','Y'),(76,'2024-11-12 11:04:15.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','Y'),(77,'2024-11-12 11:04:15.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','Y'),(78,'2024-11-12 11:04:16.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','Y'),(79,'2024-11-13 07:56:32.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(80,'2024-11-13 07:56:32.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(81,'2024-11-13 07:56:32.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(82,'2024-11-13 07:56:32.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(83,'2024-11-13 07:56:32.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(84,'2024-11-13 07:56:33.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(85,'2024-11-13 08:03:51.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(86,'2024-11-13 08:03:51.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(87,'2024-11-13 08:03:51.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(88,'2024-11-13 08:03:51.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(89,'2024-11-13 08:03:51.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(90,'2024-11-13 08:03:52.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(91,'2024-11-13 08:03:58.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(92,'2024-11-13 08:03:58.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(93,'2024-11-13 08:03:59.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(94,'2024-11-13 08:03:59.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(95,'2024-11-13 08:03:59.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(96,'2024-11-13 08:03:59.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(97,'2024-11-13 09:05:47.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(98,'2024-11-13 09:05:47.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(99,'2024-11-13 09:05:48.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(100,'2024-11-13 09:05:48.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(101,'2024-11-13 09:05:48.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(102,'2024-11-13 09:05:48.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(103,'2024-11-14 09:03:01.000','10','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(104,'2024-11-14 09:03:01.000','32','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(105,'2024-11-14 09:03:01.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(106,'2024-11-14 09:03:01.000','500','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(107,'2024-11-14 09:03:01.000','10','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(108,'2024-11-14 09:03:02.000','10','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(109,'2024-11-14 09:10:45.000','122','b785c4b1-9cb7-4755-bd3a-985b22dcf762','N'),(110,'2024-11-14 09:10:45.000','222','36a8b13c-9217-43f6-9c9f-234e26c4f4a1','N'),(111,'2024-11-14 09:10:45.000','250','0bec1e99-e7e7-4ed1-aed8-d8184bed68ff','N'),(112,'2024-11-14 09:10:45.000','120','e25642b7-8fe8-4711-9185-f46f5e5fbc31','N'),(113,'2024-11-14 10:36:06.000','34','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(114,'2024-11-14 10:36:07.000','34','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(115,'2024-11-14 10:36:07.000','34','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(116,'2024-11-14 10:36:07.000','34','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(117,'2024-11-14 10:36:07.000','34','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(118,'2024-11-14 10:36:07.000','34','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(119,'2024-11-14 10:39:24.000','34','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(120,'2024-11-14 10:39:24.000','34','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(121,'2024-11-14 10:39:24.000','34','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(122,'2024-11-14 10:39:24.000','34','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(123,'2024-11-14 10:39:24.000','34','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(124,'2024-11-14 10:39:24.000','35','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(125,'2024-11-14 10:41:57.000','44','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(126,'2024-11-14 10:41:57.000','344','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(127,'2024-11-14 10:41:57.000','344','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(128,'2024-11-14 10:41:57.000','344','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(129,'2024-11-14 10:41:57.000','45','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(130,'2024-11-14 10:41:57.000','44','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(131,'2024-11-14 10:44:17.000','44','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(132,'2024-11-14 10:44:17.000','344','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(133,'2024-11-14 10:44:17.000','344','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(134,'2024-11-14 10:44:18.000','344','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(135,'2024-11-14 10:44:18.000','45','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(136,'2024-11-14 10:44:18.000','44','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(137,'2024-11-14 10:45:24.000','64','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(138,'2024-11-14 10:45:24.000','64','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(139,'2024-11-14 10:45:24.000','34','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(140,'2024-11-14 10:45:25.000','34','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(141,'2024-11-14 10:45:25.000','44','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(142,'2024-11-14 10:45:25.000','65','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(143,'2024-11-14 10:45:34.000','34','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(144,'2024-11-14 10:45:35.000','44','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(145,'2024-11-14 10:45:35.000','65','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(146,'2024-11-14 10:45:35.000','64','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(147,'2024-11-14 10:45:35.000','64','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(148,'2024-11-14 10:45:35.000','34','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(149,'2024-11-14 10:47:04.000','1','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(150,'2024-11-14 10:47:04.000','1','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(151,'2024-11-14 10:47:04.000','1','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(152,'2024-11-14 10:47:04.000','1','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(153,'2024-11-14 10:47:05.000','1','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(154,'2024-11-14 10:47:05.000','1','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(155,'2024-11-14 10:50:26.000','21','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(156,'2024-11-14 10:50:26.000','21','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(157,'2024-11-14 10:50:26.000','21','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(158,'2024-11-14 10:50:26.000','21','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(159,'2024-11-14 10:50:26.000','21','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(160,'2024-11-14 10:50:27.000','21','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(161,'2024-11-14 10:52:02.000','61','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(162,'2024-11-14 10:52:02.000','61','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(163,'2024-11-14 10:52:02.000','61','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(164,'2024-11-14 10:52:03.000','61','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(165,'2024-11-14 10:52:03.000','61','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(166,'2024-11-14 10:52:03.000','61','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(167,'2024-11-14 10:52:47.000','51','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(168,'2024-11-14 10:52:47.000','51','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(169,'2024-11-14 10:52:47.000','51','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(170,'2024-11-14 10:52:47.000','51','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(171,'2024-11-14 10:52:47.000','51','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(172,'2024-11-14 10:52:47.000','51','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(173,'2024-11-14 10:55:59.000','151','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(174,'2024-11-14 10:56:00.000','151','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(175,'2024-11-14 10:56:00.000','151','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(176,'2024-11-14 10:56:00.000','151','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(177,'2024-11-14 10:56:00.000','151','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(178,'2024-11-14 10:56:00.000','151','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(179,'2024-11-14 10:59:55.000','15','7e6a8ded-4422-4b17-98ad-1fe8d6f2882f','N'),(180,'2024-11-14 10:59:55.000','15','f70fe243-ebe4-4e57-a407-67539f885e37','N'),(181,'2024-11-14 10:59:56.000','15','77a2ead0-6546-463a-b478-57f82ecfdf0e','N'),(182,'2024-11-14 10:59:56.000','15','135d120b-2fcd-4e1b-8881-155d754884a1','N'),(183,'2024-11-14 10:59:56.000','15','bc839671-1cea-4782-a282-5c95d408cc5a','N'),(184,'2024-11-14 10:59:56.000','15','64d6d3cc-aca4-401f-973a-b3bab1f9d4e8','N'),(185,'2024-11-14 12:12:27.000','23','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(186,'2024-11-14 12:12:27.000','23','13def9cb-d805-46eb-ac04-a023024099b8','N'),(187,'2024-11-14 12:12:27.000','23','ab300284-2fb8-488b-b375-94b884b599d3','N'),(188,'2024-11-14 12:12:27.000','23','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(189,'2024-11-14 12:12:27.000','23','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(190,'2024-11-19 05:23:57.000','45','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(191,'2024-11-19 05:24:43.000','45','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(192,'2024-11-19 05:24:43.000','45','13def9cb-d805-46eb-ac04-a023024099b8','N'),(193,'2024-11-19 05:24:43.000','45','ab300284-2fb8-488b-b375-94b884b599d3','N'),(194,'2024-11-19 05:24:43.000','45','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(195,'2024-11-19 05:24:43.000','45','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(196,'2024-11-19 05:25:03.000','5','ab300284-2fb8-488b-b375-94b884b599d3','N'),(197,'2024-11-19 05:25:03.000','5','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(198,'2024-11-19 05:25:03.000','5','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(199,'2024-11-19 05:25:04.000','5','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(200,'2024-11-19 05:25:04.000','5','13def9cb-d805-46eb-ac04-a023024099b8','N'),(201,'2024-11-19 05:25:23.000','5','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(202,'2024-11-19 05:25:23.000','5','13def9cb-d805-46eb-ac04-a023024099b8','N'),(203,'2024-11-19 05:25:23.000','5','ab300284-2fb8-488b-b375-94b884b599d3','N'),(204,'2024-11-19 05:25:24.000','5','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(205,'2024-11-19 05:25:24.000','5','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(206,'2024-11-19 05:26:01.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(207,'2024-11-19 05:26:09.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(208,'2024-11-19 05:30:00.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(209,'2024-11-19 05:30:00.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(210,'2024-11-19 05:30:01.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(211,'2024-11-19 05:30:01.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(212,'2024-11-19 05:30:01.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(213,'2024-11-19 05:30:01.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(214,'2024-11-19 05:30:02.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(215,'2024-11-19 05:30:02.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(216,'2024-11-19 05:30:02.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(217,'2024-11-19 05:30:02.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(218,'2024-11-19 05:30:02.000','511','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(219,'2024-11-19 05:30:33.000','5','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(220,'2024-11-19 05:30:33.000','5','13def9cb-d805-46eb-ac04-a023024099b8','N'),(221,'2024-11-19 05:30:33.000','5','ab300284-2fb8-488b-b375-94b884b599d3','N'),(222,'2024-11-19 05:30:33.000','5','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(223,'2024-11-19 05:30:33.000','5','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(224,'2024-11-19 05:30:45.000','5','13def9cb-d805-46eb-ac04-a023024099b8','N'),(225,'2024-11-19 05:30:45.000','5','ab300284-2fb8-488b-b375-94b884b599d3','N'),(226,'2024-11-19 05:30:45.000','5','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(227,'2024-11-19 05:30:45.000','5','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(228,'2024-11-19 05:30:45.000','5','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(229,'2024-11-19 05:36:29.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(230,'2024-11-19 05:36:31.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(231,'2024-11-19 05:36:37.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(232,'2024-11-19 05:36:38.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(233,'2024-11-19 05:36:39.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(234,'2024-11-19 05:36:44.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(235,'2024-11-19 05:36:44.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(236,'2024-11-19 05:36:44.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(237,'2024-11-19 05:36:45.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(238,'2024-11-19 05:36:45.000','25','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(239,'2024-11-19 05:43:10.000','26','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(240,'2024-11-19 05:43:12.000','26','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(241,'2024-11-19 10:45:34.000','300','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(242,'2024-11-19 10:45:34.000','11','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(243,'2024-11-19 10:45:35.000','11','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(244,'2024-11-19 10:45:35.000','11','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(245,'2024-11-19 10:45:35.000','12','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(246,'2024-11-19 10:45:35.000','22','544b8734-5609-42ed-8a03-7222cb65984d','N'),(247,'2024-11-19 10:47:27.000','5','544b8734-5609-42ed-8a03-7222cb65984d','N'),(248,'2024-11-19 10:47:28.000','35','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(249,'2024-11-19 10:47:28.000','5','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(250,'2024-11-19 10:47:28.000','5','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(251,'2024-11-19 10:47:28.000','5','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(252,'2024-11-19 10:47:28.000','5','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(253,'2024-11-19 10:56:01.000','5','544b8734-5609-42ed-8a03-7222cb65984d','N'),(254,'2024-11-19 10:56:01.000','35','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(255,'2024-11-19 10:56:01.000','5','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(256,'2024-11-19 10:56:01.000','5','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(257,'2024-11-19 10:56:01.000','5','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(258,'2024-11-19 10:56:02.000','5','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(259,'2024-11-19 11:03:42.000','5','544b8734-5609-42ed-8a03-7222cb65984d','N'),(260,'2024-11-19 11:03:42.000','35','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(261,'2024-11-19 11:03:42.000','5','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(262,'2024-11-19 11:03:42.000','5','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(263,'2024-11-19 11:03:42.000','5','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(264,'2024-11-19 11:03:42.000','5','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(265,'2024-11-19 11:04:07.000','40','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(266,'2024-11-19 11:04:07.000','5','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(267,'2024-11-19 11:04:07.000','5','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(268,'2024-11-19 11:04:08.000','5','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(269,'2024-11-19 11:04:08.000','5','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(270,'2024-11-19 11:04:08.000','5','544b8734-5609-42ed-8a03-7222cb65984d','N'),(271,'2024-11-19 11:07:10.000','5','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(272,'2024-11-19 11:07:10.000','5','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(273,'2024-11-19 11:07:10.000','5','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(274,'2024-11-19 11:07:10.000','5','544b8734-5609-42ed-8a03-7222cb65984d','N'),(275,'2024-11-19 11:07:10.000','30','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(276,'2024-11-19 11:07:11.000','5','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(277,'2024-11-19 11:25:41.000','25','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(278,'2024-11-19 11:25:41.000','25','544b8734-5609-42ed-8a03-7222cb65984d','N'),(279,'2024-11-19 11:25:41.000','230','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(280,'2024-11-19 11:25:42.000','25','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(281,'2024-11-19 11:25:42.000','25','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(282,'2024-11-19 11:25:42.000','25','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(283,'2024-11-20 03:59:34.000','1','b785c4b1-9cb7-4755-bd3a-985b22dcf762','N'),(284,'2024-11-20 03:59:34.000','1','36a8b13c-9217-43f6-9c9f-234e26c4f4a1','N'),(285,'2024-11-20 03:59:34.000','1','0bec1e99-e7e7-4ed1-aed8-d8184bed68ff','N'),(286,'2024-11-20 03:59:34.000','1','e25642b7-8fe8-4711-9185-f46f5e5fbc31','N'),(287,'2024-11-20 04:02:41.000','2','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(288,'2024-11-20 04:02:41.000','2','820bfada-05dc-4f98-9966-a35e984219d0','N'),(289,'2024-11-20 04:02:41.000','2','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(290,'2024-11-20 04:02:41.000','2','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(291,'2024-11-20 04:02:42.000','2','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(292,'2024-11-20 04:02:42.000','2','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(293,'2024-11-20 04:02:43.000','2','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(294,'2024-11-20 04:02:43.000','2','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(295,'2024-11-20 04:02:43.000','2','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(296,'2024-11-20 04:02:43.000','2','820bfada-05dc-4f98-9966-a35e984219d0','N'),(297,'2024-11-20 04:05:01.000','3','ee130acf-365d-43b6-8483-8dca088c1158','N'),(298,'2024-11-20 04:05:01.000','3','92540c72-e3d6-48a4-9bef-2d9bbd2a4c7f','N'),(299,'2024-11-20 04:05:01.000','3','f99d3324-c299-4ced-88f8-7f032b7a6805','N'),(300,'2024-11-20 04:05:01.000','3','b613115b-c708-4c67-9e3c-072bf43c2ef4','N'),(301,'2024-11-20 04:08:53.000','3','b613115b-c708-4c67-9e3c-072bf43c2ef4','N'),(302,'2024-11-20 04:08:54.000','3','ee130acf-365d-43b6-8483-8dca088c1158','N'),(303,'2024-11-20 04:08:54.000','3','92540c72-e3d6-48a4-9bef-2d9bbd2a4c7f','N'),(304,'2024-11-20 04:08:54.000','3','f99d3324-c299-4ced-88f8-7f032b7a6805','N'),(305,'2024-11-20 04:09:09.000','4','92540c72-e3d6-48a4-9bef-2d9bbd2a4c7f','N'),(306,'2024-11-20 04:09:09.000','4','f99d3324-c299-4ced-88f8-7f032b7a6805','N'),(307,'2024-11-20 04:09:09.000','4','b613115b-c708-4c67-9e3c-072bf43c2ef4','N'),(308,'2024-11-20 04:09:09.000','4','ee130acf-365d-43b6-8483-8dca088c1158','N'),(309,'2024-11-20 04:12:34.000','4','ee130acf-365d-43b6-8483-8dca088c1158','N'),(310,'2024-11-20 04:12:34.000','4','92540c72-e3d6-48a4-9bef-2d9bbd2a4c7f','N'),(311,'2024-11-20 04:12:34.000','4','f99d3324-c299-4ced-88f8-7f032b7a6805','N'),(312,'2024-11-20 04:12:34.000','4','b613115b-c708-4c67-9e3c-072bf43c2ef4','N'),(313,'2024-11-20 04:15:08.000','34','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(314,'2024-11-20 06:50:19.000','25','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(315,'2024-11-20 06:50:19.000','25','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(316,'2024-11-20 06:50:19.000','25','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(317,'2024-11-20 06:50:19.000','25','544b8734-5609-42ed-8a03-7222cb65984d','N'),(318,'2024-11-20 06:50:19.000','230','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(319,'2024-11-20 06:50:19.000','25','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(320,'2024-11-20 06:51:19.000','25','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(321,'2024-11-20 06:51:46.000','25','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(322,'2024-11-20 06:51:46.000','25','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(323,'2024-11-20 06:51:47.000','25','544b8734-5609-42ed-8a03-7222cb65984d','N'),(324,'2024-11-20 06:51:47.000','230','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(325,'2024-11-20 06:51:47.000','25','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(326,'2024-11-20 06:51:47.000','25','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(327,'2024-11-20 06:53:53.000','25','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(328,'2024-11-20 06:53:53.000','25','544b8734-5609-42ed-8a03-7222cb65984d','N'),(329,'2024-11-20 06:53:53.000','230','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(330,'2024-11-20 06:53:53.000','25','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(331,'2024-11-20 06:53:53.000','25','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(332,'2024-11-20 06:53:53.000','25','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(333,'2024-11-20 06:54:06.000','25','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(334,'2024-11-20 06:54:06.000','25','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(335,'2024-11-20 06:54:06.000','25','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(336,'2024-11-20 06:54:06.000','25','544b8734-5609-42ed-8a03-7222cb65984d','N'),(337,'2024-11-20 06:54:06.000','230','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(338,'2024-11-20 06:54:06.000','25','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(339,'2024-11-20 06:54:11.000','25','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(340,'2024-11-20 06:54:11.000','25','544b8734-5609-42ed-8a03-7222cb65984d','N'),(341,'2024-11-20 06:54:11.000','230','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(342,'2024-11-20 06:54:11.000','25','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(343,'2024-11-20 06:54:11.000','25','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(344,'2024-11-20 06:54:11.000','25','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(345,'2024-11-20 07:25:45.000','23','13def9cb-d805-46eb-ac04-a023024099b8','N'),(346,'2024-11-20 07:25:45.000','23','ab300284-2fb8-488b-b375-94b884b599d3','N'),(347,'2024-11-20 07:25:45.000','23','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(348,'2024-11-20 07:25:45.000','23','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(349,'2024-11-20 07:25:45.000','23','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(350,'2024-11-20 07:30:39.000','23','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(351,'2024-11-20 07:30:39.000','23','13def9cb-d805-46eb-ac04-a023024099b8','N'),(352,'2024-11-20 07:30:39.000','23','ab300284-2fb8-488b-b375-94b884b599d3','N'),(353,'2024-11-20 07:30:39.000','23','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(354,'2024-11-20 07:30:39.000','23','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(355,'2024-11-20 07:31:57.000','23','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(356,'2024-11-20 07:31:57.000','23','13def9cb-d805-46eb-ac04-a023024099b8','N'),(357,'2024-11-20 07:31:57.000','23','ab300284-2fb8-488b-b375-94b884b599d3','N'),(358,'2024-11-20 07:31:57.000','23','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(359,'2024-11-20 07:31:57.000','23','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(360,'2024-11-20 07:37:16.000','23','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(361,'2024-11-20 07:37:16.000','23','13def9cb-d805-46eb-ac04-a023024099b8','N'),(362,'2024-11-20 07:37:16.000','23','ab300284-2fb8-488b-b375-94b884b599d3','N'),(363,'2024-11-20 07:37:16.000','23','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(364,'2024-11-20 07:37:16.000','23','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(365,'2024-11-20 07:45:48.000','21','cfb66322-561c-418a-bbc1-d761a36ac620','N'),(366,'2024-11-20 07:45:48.000','23','13def9cb-d805-46eb-ac04-a023024099b8','N'),(367,'2024-11-20 07:45:48.000','3','ab300284-2fb8-488b-b375-94b884b599d3','N'),(368,'2024-11-20 07:45:48.000','3','106e2b52-4e2a-4b45-9614-5e710d4db639','N'),(369,'2024-11-20 07:45:48.000','203','bc7b96b4-3520-4317-acb2-d509ee963dfd','N'),(370,'2024-11-20 10:32:07.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(371,'2024-11-20 10:32:07.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(372,'2024-11-20 10:32:07.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(373,'2024-11-20 10:32:07.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(374,'2024-11-20 10:32:07.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(375,'2024-11-20 10:34:03.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(376,'2024-11-20 10:34:03.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(377,'2024-11-20 10:34:03.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(378,'2024-11-20 10:34:03.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(379,'2024-11-20 10:34:03.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(380,'2024-11-20 10:34:20.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(381,'2024-11-20 10:34:20.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(382,'2024-11-20 10:34:20.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(383,'2024-11-20 10:34:20.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(384,'2024-11-20 10:34:20.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(385,'2024-11-21 07:26:49.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(386,'2024-11-21 07:26:49.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(387,'2024-11-21 07:26:50.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(388,'2024-11-21 07:26:50.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(389,'2024-11-21 07:26:50.000','300','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(390,'2024-11-21 07:31:26.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(391,'2024-11-21 07:31:26.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(392,'2024-11-21 07:31:27.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(393,'2024-11-21 07:31:27.000','400','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(394,'2024-11-21 07:31:27.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(395,'2024-11-21 07:31:58.000','56','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(396,'2024-11-21 07:31:58.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(397,'2024-11-21 07:31:58.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(398,'2024-11-21 07:31:59.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(399,'2024-11-21 07:31:59.000','400','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(400,'2024-11-21 07:32:57.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(401,'2024-11-21 07:32:57.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(402,'2024-11-21 07:32:58.000','400','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(403,'2024-11-21 07:32:58.000','56','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(404,'2024-11-21 07:32:58.000','0.1','544b8734-5609-42ed-8a03-7222cb65984d','N'),(405,'2024-11-27 09:39:16.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(406,'2024-11-27 09:39:16.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(407,'2024-11-27 09:39:16.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(408,'2024-11-27 09:39:16.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(409,'2024-11-27 09:39:16.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(410,'2024-11-27 09:39:17.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(411,'2024-11-27 09:39:17.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(412,'2024-11-27 09:39:17.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(413,'2024-11-27 09:39:17.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(414,'2024-11-27 09:39:17.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(415,'2024-11-27 10:05:05.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(416,'2024-11-27 10:05:05.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(417,'2024-11-27 10:05:05.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(418,'2024-11-27 10:05:05.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(419,'2024-11-27 10:05:05.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(420,'2024-11-27 10:05:39.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(421,'2024-11-27 10:05:39.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(422,'2024-11-27 10:05:39.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(423,'2024-11-27 10:05:39.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(424,'2024-11-27 10:05:39.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(425,'2024-11-27 10:05:39.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(426,'2024-11-27 10:05:39.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(427,'2024-11-27 10:05:39.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(428,'2024-11-27 10:05:39.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(429,'2024-11-27 10:05:39.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(430,'2024-11-27 10:05:40.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(431,'2024-11-27 10:05:40.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(432,'2024-11-27 10:05:40.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(433,'2024-11-27 10:05:40.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(434,'2024-11-27 10:05:40.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(435,'2024-11-27 10:05:40.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(436,'2024-11-27 10:05:40.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(437,'2024-11-27 10:05:41.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(438,'2024-11-27 10:05:41.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(439,'2024-11-27 10:05:41.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(440,'2024-11-27 10:05:41.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(441,'2024-11-27 10:05:41.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(442,'2024-11-27 10:05:41.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(443,'2024-11-27 10:05:41.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(444,'2024-11-27 10:05:41.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(445,'2024-11-27 10:05:41.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(446,'2024-11-27 10:05:41.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(447,'2024-11-27 10:05:41.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(448,'2024-11-27 10:05:41.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(449,'2024-11-27 10:05:41.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(450,'2024-11-27 10:05:42.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(451,'2024-11-27 10:05:42.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(452,'2024-11-27 10:05:42.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(453,'2024-11-27 10:05:42.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(454,'2024-11-27 10:05:42.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(455,'2024-11-27 10:05:42.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(456,'2024-11-27 10:05:42.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(457,'2024-11-27 10:05:42.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(458,'2024-11-27 10:05:42.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(459,'2024-11-27 10:05:42.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(460,'2024-11-27 10:05:42.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(461,'2024-11-27 10:05:42.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(462,'2024-11-27 10:05:42.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(463,'2024-11-27 10:05:42.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(464,'2024-11-27 10:05:42.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(465,'2024-11-27 10:05:42.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(466,'2024-11-27 10:05:42.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(467,'2024-11-27 10:05:42.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(468,'2024-11-27 10:05:43.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(469,'2024-11-27 10:05:43.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(470,'2024-11-27 10:05:43.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(471,'2024-11-27 10:05:43.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(472,'2024-11-27 10:05:43.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(473,'2024-11-27 10:05:43.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(474,'2024-11-27 10:05:43.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(475,'2024-11-27 10:12:28.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(476,'2024-11-27 10:12:28.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(477,'2024-11-27 10:12:28.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(478,'2024-11-27 10:12:29.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(479,'2024-11-27 10:12:29.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(480,'2024-11-27 10:22:57.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(481,'2024-11-27 10:22:57.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(482,'2024-11-27 10:22:57.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(483,'2024-11-27 10:22:57.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(484,'2024-11-27 10:22:57.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(485,'2024-11-27 10:22:59.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(486,'2024-11-27 10:22:59.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(487,'2024-11-27 10:22:59.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(488,'2024-11-27 10:22:59.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(489,'2024-11-27 10:22:59.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(490,'2024-11-27 10:23:00.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(491,'2024-11-27 10:23:00.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(492,'2024-11-27 10:23:00.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(493,'2024-11-27 10:23:00.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(494,'2024-11-27 10:23:00.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(495,'2024-11-27 10:23:01.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(496,'2024-11-27 10:23:01.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(497,'2024-11-27 10:23:01.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(498,'2024-11-27 10:23:01.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(499,'2024-11-27 10:23:01.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(500,'2024-11-27 10:47:03.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(501,'2024-11-27 10:47:03.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(502,'2024-11-27 10:47:03.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(503,'2024-11-27 10:47:03.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(504,'2024-11-27 10:47:03.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(505,'2024-11-27 10:47:04.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(506,'2024-11-27 10:47:04.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(507,'2024-11-27 10:47:04.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(508,'2024-11-27 10:47:04.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(509,'2024-11-27 10:47:04.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(510,'2024-11-27 10:47:52.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(511,'2024-11-27 10:47:52.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(512,'2024-11-27 10:47:52.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(513,'2024-11-27 10:47:52.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(514,'2024-11-27 10:47:52.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(515,'2024-11-27 10:47:53.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(516,'2024-11-27 10:47:53.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(517,'2024-11-27 10:47:53.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(518,'2024-11-27 10:47:53.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(519,'2024-11-27 10:47:53.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(520,'2024-11-27 10:47:55.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(521,'2024-11-27 10:47:55.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(522,'2024-11-27 10:47:55.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(523,'2024-11-27 10:47:55.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(524,'2024-11-27 10:47:55.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(525,'2024-11-27 10:47:55.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(526,'2024-11-27 10:47:56.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(527,'2024-11-27 10:47:56.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(528,'2024-11-27 10:47:56.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(529,'2024-11-27 10:47:56.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(530,'2024-11-27 10:47:56.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(531,'2024-11-27 10:47:56.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(532,'2024-11-27 10:47:56.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(533,'2024-11-27 10:47:56.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(534,'2024-11-27 10:47:56.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(535,'2024-11-27 11:38:50.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(536,'2024-11-27 11:38:50.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(537,'2024-11-27 11:38:50.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(538,'2024-11-27 11:38:50.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(539,'2024-11-27 11:38:50.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(540,'2024-11-27 11:38:50.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(541,'2024-11-27 11:38:50.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(542,'2024-11-27 11:38:50.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(543,'2024-11-27 11:38:50.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(544,'2024-11-27 11:38:51.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(545,'2024-11-27 11:45:11.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(546,'2024-11-27 11:45:11.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(547,'2024-11-27 11:45:11.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(548,'2024-11-27 11:45:11.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(549,'2024-11-27 11:45:11.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(550,'2024-11-27 11:55:12.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(551,'2024-11-27 11:55:12.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(552,'2024-11-27 11:55:12.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(553,'2024-11-27 11:55:12.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(554,'2024-11-27 11:55:12.000','203','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(555,'2024-11-28 04:04:23.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(556,'2024-11-28 04:04:23.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(557,'2024-11-28 04:04:23.000','105','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(558,'2024-11-28 04:04:23.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(559,'2024-11-28 04:04:23.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(560,'2024-11-28 04:05:42.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(561,'2024-11-28 04:05:42.000','105','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(562,'2024-11-28 04:05:42.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(563,'2024-11-28 04:05:42.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(564,'2024-11-28 04:05:42.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(567,'2024-11-28 04:38:00.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(568,'2024-11-28 04:38:00.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(569,'2024-11-28 04:38:00.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(570,'2024-11-28 04:38:00.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(571,'2024-11-28 04:38:00.000','105','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(572,'2024-11-28 04:40:53.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(573,'2024-11-28 04:40:53.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(574,'2024-11-28 04:40:53.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(575,'2024-11-28 04:40:53.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(576,'2024-11-28 04:40:53.000','20','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(577,'2024-11-28 04:40:53.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(578,'2024-11-28 04:40:53.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(579,'2024-11-28 04:40:53.000','20','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(580,'2024-11-28 04:40:53.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(581,'2024-11-28 04:40:53.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(582,'2024-11-28 04:44:11.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(583,'2024-11-28 04:44:11.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(584,'2024-11-28 04:44:11.000','20','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(585,'2024-11-28 04:44:12.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(586,'2024-11-28 04:44:12.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(587,'2024-11-28 04:44:13.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(588,'2024-11-28 04:44:13.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(589,'2024-11-28 04:44:13.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(590,'2024-11-28 04:44:13.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(591,'2024-11-28 04:44:13.000','20','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(592,'2024-11-28 04:49:41.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(593,'2024-11-28 04:49:41.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(594,'2024-11-28 04:49:41.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(595,'2024-11-28 04:49:41.000','20','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(596,'2024-11-28 04:49:41.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(597,'2024-11-28 04:50:03.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(598,'2024-11-28 04:50:03.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(599,'2024-11-28 04:50:03.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(600,'2024-11-28 04:50:03.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(601,'2024-11-28 04:50:04.000','20','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(602,'2024-11-28 04:52:52.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(603,'2024-11-28 04:52:53.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(604,'2024-11-28 04:52:53.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(605,'2024-11-28 04:52:53.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(606,'2024-11-28 04:52:53.000','20','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(607,'2024-11-28 05:07:39.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(608,'2024-11-28 05:07:39.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(609,'2024-11-28 05:07:39.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(610,'2024-11-28 05:07:39.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(611,'2024-11-28 05:07:39.000','20','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(612,'2024-11-28 05:07:51.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(613,'2024-11-28 05:07:51.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(614,'2024-11-28 05:07:51.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(615,'2024-11-28 05:07:51.000','32','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(616,'2024-11-28 05:07:51.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(617,'2024-11-28 05:08:09.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(618,'2024-11-28 05:08:09.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(619,'2024-11-28 05:08:09.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(620,'2024-11-28 05:08:09.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(621,'2024-11-28 05:08:09.000','90','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(622,'2024-11-28 05:41:54.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(623,'2024-11-28 05:42:56.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(624,'2024-11-28 05:44:09.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(625,'2024-11-28 05:51:40.000','94','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(626,'2024-11-28 05:51:40.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(627,'2024-11-28 05:51:40.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(628,'2024-11-28 05:51:40.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(629,'2024-11-28 05:51:40.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(630,'2024-11-28 05:52:01.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(631,'2024-11-28 05:52:01.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(632,'2024-11-28 05:52:01.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(633,'2024-11-28 05:52:01.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(634,'2024-11-28 05:52:01.000','94','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(635,'2024-11-28 05:52:01.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(636,'2024-11-28 05:52:01.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(637,'2024-11-28 05:52:01.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(638,'2024-11-28 05:52:01.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(639,'2024-11-28 05:52:01.000','94','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(640,'2024-11-28 05:55:29.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(641,'2024-11-28 05:55:29.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(642,'2024-11-28 05:55:29.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(643,'2024-11-28 05:55:29.000','94','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(644,'2024-11-28 05:55:30.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(645,'2024-11-28 06:03:16.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(646,'2024-11-28 06:03:16.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(647,'2024-11-28 06:03:16.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(648,'2024-11-28 06:03:16.000','94','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(649,'2024-11-28 06:03:16.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(650,'2024-11-28 06:05:41.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(651,'2024-11-28 06:05:41.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(652,'2024-11-28 06:05:41.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(653,'2024-11-28 06:05:41.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(654,'2024-11-28 06:05:41.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(655,'2024-11-28 06:09:21.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(656,'2024-11-28 06:09:21.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(657,'2024-11-28 06:09:21.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(658,'2024-11-28 06:09:22.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(659,'2024-11-28 06:09:22.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(660,'2024-11-28 06:17:19.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(661,'2024-11-28 06:17:19.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(662,'2024-11-28 06:17:19.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(663,'2024-11-28 06:17:19.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(664,'2024-11-28 06:17:20.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(665,'2024-11-28 06:20:25.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(666,'2024-11-28 06:20:26.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(667,'2024-11-28 06:20:26.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(668,'2024-11-28 06:20:26.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(669,'2024-11-28 06:20:26.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(670,'2024-11-28 06:30:08.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(671,'2024-11-28 06:30:08.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(672,'2024-11-28 06:30:08.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(673,'2024-11-28 06:30:08.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(674,'2024-11-28 06:30:09.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(675,'2024-11-28 06:30:17.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(676,'2024-11-28 06:30:17.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(677,'2024-11-28 06:30:17.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(678,'2024-11-28 06:30:17.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(679,'2024-11-28 06:30:17.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(680,'2024-11-28 06:30:18.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(681,'2024-11-28 06:30:18.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(682,'2024-11-28 06:30:18.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(683,'2024-11-28 06:30:18.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(684,'2024-11-28 06:30:18.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(685,'2024-11-28 06:31:16.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(686,'2024-11-28 06:31:16.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(687,'2024-11-28 06:31:16.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(688,'2024-11-28 06:31:16.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(689,'2024-11-28 06:31:16.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(690,'2024-11-28 06:32:02.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(691,'2024-11-28 06:32:02.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(692,'2024-11-28 06:32:02.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(693,'2024-11-28 06:32:02.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(694,'2024-11-28 06:32:02.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(695,'2024-11-28 06:32:03.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(696,'2024-11-28 06:32:03.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(697,'2024-11-28 06:32:03.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(698,'2024-11-28 06:32:03.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(699,'2024-11-28 06:32:03.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(700,'2024-11-28 06:32:03.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(701,'2024-11-28 06:32:04.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(702,'2024-11-28 06:32:04.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(703,'2024-11-28 06:32:04.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(704,'2024-11-28 06:32:04.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(705,'2024-11-28 06:32:17.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(706,'2024-11-28 06:32:17.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(707,'2024-11-28 06:32:17.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(708,'2024-11-28 06:32:18.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(709,'2024-11-28 06:32:18.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(710,'2024-11-28 06:32:59.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(711,'2024-11-28 06:32:59.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(712,'2024-11-28 06:32:59.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(713,'2024-11-28 06:33:00.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(714,'2024-11-28 06:33:00.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(715,'2024-11-28 06:33:00.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(716,'2024-11-28 06:33:00.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(717,'2024-11-28 06:33:00.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(718,'2024-11-28 06:33:01.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(719,'2024-11-28 06:33:01.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(720,'2024-11-28 06:33:02.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(721,'2024-11-28 06:33:02.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(722,'2024-11-28 06:33:02.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(723,'2024-11-28 06:33:02.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(724,'2024-11-28 06:33:03.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(725,'2024-11-28 06:34:04.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(726,'2024-11-28 06:34:04.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(727,'2024-11-28 06:34:04.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(728,'2024-11-28 06:34:04.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(729,'2024-11-28 06:34:04.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(730,'2024-11-28 06:34:39.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(731,'2024-11-28 06:34:39.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(732,'2024-11-28 06:34:40.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(733,'2024-11-28 06:34:40.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(734,'2024-11-28 06:34:40.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(735,'2024-11-28 06:34:41.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(736,'2024-11-28 06:34:41.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(737,'2024-11-28 06:34:41.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(738,'2024-11-28 06:34:41.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(739,'2024-11-28 06:34:41.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(740,'2024-11-28 06:36:22.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(741,'2024-11-28 06:36:22.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(742,'2024-11-28 06:36:22.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(743,'2024-11-28 06:36:23.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(744,'2024-11-28 06:36:23.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(745,'2024-11-28 06:36:23.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(746,'2024-11-28 06:36:23.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(747,'2024-11-28 06:36:24.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(748,'2024-11-28 06:36:24.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(749,'2024-11-28 06:36:24.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(750,'2024-11-28 06:41:12.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(751,'2024-11-28 06:41:12.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(752,'2024-11-28 06:41:12.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(753,'2024-11-28 06:41:12.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(754,'2024-11-28 06:41:12.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(755,'2024-11-28 06:43:43.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(756,'2024-11-28 06:43:43.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(757,'2024-11-28 06:43:43.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(758,'2024-11-28 06:43:44.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(759,'2024-11-28 06:43:44.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(760,'2024-11-28 06:45:12.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(761,'2024-11-28 06:45:12.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(762,'2024-11-28 06:45:12.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(763,'2024-11-28 06:45:12.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(764,'2024-11-28 06:45:12.000','100','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(765,'2024-11-28 06:45:37.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(766,'2024-11-28 06:45:37.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(767,'2024-11-28 06:45:37.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(768,'2024-11-28 06:45:37.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(769,'2024-11-28 06:45:37.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(770,'2024-11-28 08:05:54.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(771,'2024-11-28 08:05:54.000','69','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(772,'2024-11-28 08:05:54.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(773,'2024-11-28 08:05:55.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(774,'2024-11-28 08:05:55.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(775,'2024-11-28 08:05:56.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(776,'2024-11-28 08:05:56.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(777,'2024-11-28 08:05:56.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(778,'2024-11-28 08:05:56.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(779,'2024-11-28 08:05:57.000','69','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(780,'2024-11-28 08:06:32.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(781,'2024-11-28 08:06:32.000','70','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(782,'2024-11-28 08:06:32.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(783,'2024-11-28 08:06:32.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(784,'2024-11-28 08:06:32.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(785,'2024-11-28 08:07:17.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(786,'2024-11-28 08:07:17.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(787,'2024-11-28 08:07:17.000','85','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(788,'2024-11-28 08:07:18.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(789,'2024-11-28 08:07:18.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(790,'2024-11-28 08:27:30.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(791,'2024-11-28 08:27:30.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(792,'2024-11-28 08:27:30.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(793,'2024-11-28 08:27:31.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(794,'2024-11-28 08:27:31.000','40','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(795,'2024-11-28 08:33:37.688','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(796,'2024-11-28 08:33:37.792','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(797,'2024-11-28 08:33:37.943','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(798,'2024-11-28 08:33:38.093','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(799,'2024-11-28 08:33:38.242','40','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(800,'2024-11-28 08:33:57.748','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(801,'2024-11-28 08:33:57.851','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(802,'2024-11-28 08:33:58.001','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(803,'2024-11-28 08:33:58.151','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(804,'2024-11-28 08:33:58.302','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(805,'2024-11-28 08:37:52.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(806,'2024-11-28 08:37:52.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(807,'2024-11-28 08:37:52.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(808,'2024-11-28 08:37:53.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(809,'2024-11-28 08:37:53.000','0','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(810,'2024-11-28 08:41:03.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(811,'2024-11-28 08:41:03.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(812,'2024-11-28 08:41:03.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(813,'2024-11-28 08:41:03.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(814,'2024-11-28 08:41:03.000','1','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(815,'2024-11-28 08:47:14.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(816,'2024-11-28 08:47:14.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(817,'2024-11-28 08:47:15.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(818,'2024-11-28 08:47:15.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(819,'2024-11-28 08:47:15.000','4','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(820,'2024-11-28 08:47:40.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(821,'2024-11-28 08:47:40.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(822,'2024-11-28 08:47:40.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(823,'2024-11-28 08:47:41.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(824,'2024-11-28 08:47:41.000','4','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(825,'2024-11-28 08:49:15.000','4','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(826,'2024-11-28 08:49:15.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(827,'2024-11-28 08:49:15.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(828,'2024-11-28 08:49:16.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(829,'2024-11-28 08:49:16.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(830,'2024-11-28 09:01:42.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(831,'2024-11-28 09:01:42.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(832,'2024-11-28 09:01:42.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(833,'2024-11-28 09:01:42.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(834,'2024-11-28 09:01:42.000','3','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(835,'2024-11-28 09:02:01.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(836,'2024-11-28 09:02:01.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(837,'2024-11-28 09:02:01.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(838,'2024-11-28 09:02:01.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(839,'2024-11-28 09:02:01.000','1','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(840,'2024-11-28 09:11:41.000','1','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(841,'2024-11-28 09:11:41.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(842,'2024-11-28 09:11:42.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(843,'2024-11-28 09:11:42.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(844,'2024-11-28 09:11:42.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(845,'2024-11-28 09:12:16.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(846,'2024-11-28 09:12:16.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(847,'2024-11-28 09:12:16.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(848,'2024-11-28 09:12:16.000','9','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(849,'2024-11-28 09:12:16.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(850,'2024-11-28 09:16:56.000','92','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(851,'2024-11-28 09:16:57.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(852,'2024-11-28 09:16:57.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(853,'2024-11-28 09:16:57.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(854,'2024-11-28 09:16:57.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(855,'2024-11-28 09:21:03.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(856,'2024-11-28 09:21:03.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(857,'2024-11-28 09:21:03.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(858,'2024-11-28 09:21:03.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(859,'2024-11-28 09:21:04.000','3','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(860,'2024-11-28 09:31:07.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(861,'2024-11-28 09:31:07.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(862,'2024-11-28 09:31:07.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(863,'2024-11-28 09:31:07.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(864,'2024-11-28 09:31:07.000','3','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(865,'2024-11-28 09:31:08.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(866,'2024-11-28 09:31:08.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(867,'2024-11-28 09:31:08.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(868,'2024-11-28 09:31:08.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(869,'2024-11-28 09:31:08.000','3','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(870,'2024-11-28 09:31:38.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(871,'2024-11-28 09:31:38.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(872,'2024-11-28 09:31:38.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(873,'2024-11-28 09:31:38.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(874,'2024-11-28 09:31:38.000','120','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(875,'2024-11-28 09:35:48.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(876,'2024-11-28 09:35:48.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(877,'2024-11-28 09:35:48.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(878,'2024-11-28 09:35:48.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(879,'2024-11-28 09:35:49.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(880,'2024-11-28 11:50:16.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(881,'2024-11-28 11:50:17.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(882,'2024-11-28 11:50:17.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(883,'2024-11-28 11:50:17.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(884,'2024-11-28 11:50:17.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(885,'2024-11-28 11:51:06.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(886,'2024-11-28 11:51:06.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(887,'2024-11-28 11:51:07.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(888,'2024-11-28 11:51:07.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(889,'2024-11-28 11:51:07.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(890,'2024-11-28 11:51:17.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(891,'2024-11-28 11:51:17.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(892,'2024-11-28 11:51:17.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(893,'2024-11-28 11:51:18.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(894,'2024-11-28 11:51:18.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(895,'2024-11-28 13:20:26.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(896,'2024-11-28 13:20:26.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(897,'2024-11-28 13:20:26.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(898,'2024-11-28 13:20:26.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(899,'2024-11-28 13:20:26.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(900,'2024-11-28 13:20:26.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(901,'2024-11-28 13:20:26.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(902,'2024-11-28 13:20:26.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(903,'2024-11-28 13:20:26.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(904,'2024-11-28 13:20:26.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(905,'2024-11-28 13:20:44.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(906,'2024-11-28 13:20:45.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(907,'2024-11-28 13:20:45.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(908,'2024-11-28 13:20:45.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(909,'2024-11-28 13:20:45.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(910,'2024-11-28 13:20:45.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(911,'2024-11-28 13:20:45.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(912,'2024-11-28 13:20:46.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(913,'2024-11-28 13:20:47.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(914,'2024-11-28 13:20:47.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(915,'2024-11-28 13:20:47.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(916,'2024-11-28 13:20:47.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(917,'2024-11-28 13:20:48.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(918,'2024-11-28 13:20:48.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(919,'2024-11-28 13:20:48.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(920,'2024-11-28 13:20:48.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(921,'2024-11-28 13:20:48.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(922,'2024-11-28 13:20:49.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(923,'2024-11-28 13:20:49.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(924,'2024-11-28 13:20:49.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(925,'2024-11-28 13:20:49.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(926,'2024-11-28 13:20:49.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(927,'2024-11-28 13:20:50.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(928,'2024-11-28 13:20:50.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(929,'2024-11-28 13:20:50.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(930,'2024-11-28 13:20:50.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(931,'2024-11-28 13:20:50.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(932,'2024-11-28 13:20:50.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(933,'2024-11-28 13:20:50.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(934,'2024-11-28 13:20:50.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(935,'2024-11-28 13:20:50.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(936,'2024-11-28 13:20:50.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(937,'2024-11-28 13:20:51.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(938,'2024-11-28 13:20:51.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(939,'2024-11-28 13:20:51.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(940,'2024-11-28 13:20:51.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(941,'2024-11-28 13:20:51.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(942,'2024-11-28 13:20:51.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(943,'2024-11-28 13:20:51.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(944,'2024-11-28 13:20:51.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(945,'2024-11-28 13:21:46.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(946,'2024-11-28 13:21:47.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(947,'2024-11-28 13:21:47.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(948,'2024-11-28 13:21:47.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(949,'2024-11-28 13:21:47.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(950,'2024-11-28 13:21:47.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(951,'2024-11-28 13:21:47.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(952,'2024-11-28 13:21:47.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(953,'2024-11-28 13:21:47.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(954,'2024-11-28 13:21:47.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(955,'2024-11-28 13:21:59.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(956,'2024-11-28 13:21:59.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(957,'2024-11-28 13:21:59.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(958,'2024-11-28 13:21:59.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(959,'2024-11-28 13:21:59.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(960,'2024-11-28 13:21:59.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(961,'2024-11-28 13:22:00.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(962,'2024-11-28 13:22:00.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(963,'2024-11-28 13:22:00.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(964,'2024-11-28 13:22:00.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(965,'2024-11-28 13:22:00.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(966,'2024-11-28 13:22:00.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(967,'2024-11-28 13:22:00.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(968,'2024-11-28 13:22:00.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(969,'2024-11-28 13:22:00.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(970,'2024-11-28 13:22:01.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(971,'2024-11-28 13:22:01.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(972,'2024-11-28 13:22:01.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(973,'2024-11-28 13:22:01.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(974,'2024-11-28 13:22:01.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(975,'2024-11-28 13:22:38.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(976,'2024-11-28 13:22:38.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(977,'2024-11-28 13:22:38.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(978,'2024-11-28 13:22:38.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(979,'2024-11-28 13:22:38.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(980,'2024-11-28 13:22:38.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(981,'2024-11-28 13:22:38.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(982,'2024-11-28 13:22:39.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(983,'2024-11-28 13:22:39.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(984,'2024-11-28 13:22:39.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(985,'2024-11-28 13:24:18.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(986,'2024-11-28 13:24:18.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(987,'2024-11-28 13:24:18.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(988,'2024-11-28 13:24:18.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(989,'2024-11-28 13:24:18.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(990,'2024-11-28 13:26:08.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(991,'2024-11-28 13:26:08.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(992,'2024-11-28 13:26:09.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(993,'2024-11-28 13:26:09.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(994,'2024-11-28 13:26:09.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(995,'2024-11-28 13:26:09.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(996,'2024-11-28 13:26:09.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(997,'2024-11-28 13:26:09.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(998,'2024-11-28 13:26:09.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(999,'2024-11-28 13:26:10.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1000,'2024-11-28 13:26:28.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1001,'2024-11-28 13:26:28.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1002,'2024-11-28 13:26:28.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1003,'2024-11-28 13:26:28.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1004,'2024-11-28 13:26:28.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1005,'2024-11-28 13:26:28.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1006,'2024-11-28 13:26:29.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1007,'2024-11-28 13:26:29.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1008,'2024-11-28 13:26:29.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1009,'2024-11-28 13:26:29.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1010,'2024-11-28 13:46:48.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1011,'2024-11-28 13:46:49.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1012,'2024-11-28 13:46:49.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1013,'2024-11-28 13:46:49.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1014,'2024-11-28 13:46:49.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1015,'2024-11-28 13:46:49.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1016,'2024-11-28 13:46:49.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1017,'2024-11-28 13:46:50.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1018,'2024-11-28 13:46:50.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1019,'2024-11-28 13:46:50.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1020,'2024-11-28 13:49:37.567','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1021,'2024-11-28 13:49:37.888','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1022,'2024-11-28 13:49:38.205','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1023,'2024-11-28 13:49:38.508','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1024,'2024-11-28 13:49:38.802','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1025,'2024-11-28 13:49:39.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1026,'2024-11-28 13:49:39.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1027,'2024-11-28 13:49:39.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1028,'2024-11-28 13:49:39.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1029,'2024-11-28 13:49:39.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1030,'2024-11-28 13:49:51.624','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1031,'2024-11-28 13:49:51.889','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1032,'2024-11-28 13:49:52.170','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1033,'2024-11-28 13:49:52.439','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1034,'2024-11-28 13:49:52.733','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1035,'2024-11-28 13:49:52.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1036,'2024-11-28 13:49:53.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1037,'2024-11-28 13:49:53.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1038,'2024-11-28 13:49:53.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1039,'2024-11-28 13:49:53.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1040,'2024-11-28 13:50:37.279','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1041,'2024-11-28 13:50:37.536','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1042,'2024-11-28 13:50:37.787','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1043,'2024-11-28 13:50:38.036','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1044,'2024-11-28 13:50:38.286','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1045,'2024-11-28 13:50:38.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1046,'2024-11-28 13:50:38.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1047,'2024-11-28 13:50:38.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1048,'2024-11-28 13:50:38.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1049,'2024-11-28 13:50:38.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1050,'2024-11-28 13:51:14.386','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1051,'2024-11-28 13:51:14.644','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1052,'2024-11-28 13:51:14.895','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1053,'2024-11-28 13:51:15.145','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1054,'2024-11-28 13:51:15.404','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1055,'2024-11-28 13:51:15.000','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1056,'2024-11-28 13:51:15.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1057,'2024-11-28 13:51:15.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1058,'2024-11-28 13:51:16.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1059,'2024-11-28 13:51:16.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1060,'2024-11-29 02:46:34.716','34','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1061,'2024-11-29 02:46:35.014','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1062,'2024-11-29 02:46:35.260','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1063,'2024-11-29 02:46:35.529','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1064,'2024-11-29 02:46:35.780','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1065,'2024-11-29 02:48:55.090','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1066,'2024-11-29 02:48:55.345','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1067,'2024-11-29 02:48:55.591','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1068,'2024-11-29 02:48:55.862','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1069,'2024-11-29 02:48:56.122','37','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1070,'2024-11-29 02:49:11.468','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1071,'2024-11-29 02:49:11.722','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1072,'2024-11-29 02:49:11.974','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1073,'2024-11-29 02:49:12.221','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1074,'2024-11-29 02:49:12.492','37','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1075,'2024-11-29 02:49:12.740','37','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1076,'2024-11-29 02:49:12.991','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1077,'2024-11-29 02:49:13.240','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1078,'2024-11-29 02:49:13.493','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1079,'2024-11-29 02:49:13.751','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1080,'2024-11-29 02:49:31.171','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1081,'2024-11-29 02:49:31.421','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1082,'2024-11-29 02:49:31.670','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1083,'2024-11-29 02:49:31.920','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1084,'2024-11-29 02:49:32.179','37','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1085,'2024-11-29 02:49:34.079','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1086,'2024-11-29 02:49:34.350','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1087,'2024-11-29 02:49:34.620','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1088,'2024-11-29 02:49:34.889','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1089,'2024-11-29 02:49:35.159','37','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1090,'2024-11-29 02:49:52.742','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1091,'2024-11-29 02:49:52.989','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1092,'2024-11-29 02:49:53.279','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1093,'2024-11-29 02:49:53.529','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1094,'2024-11-29 02:49:53.778','50','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1095,'2024-11-29 02:49:54.029','50','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1096,'2024-11-29 02:49:54.279','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1097,'2024-11-29 02:49:54.529','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1098,'2024-11-29 02:49:54.778','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1099,'2024-11-29 02:49:55.028','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1100,'2024-11-29 02:57:16.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1101,'2024-11-29 02:57:16.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1102,'2024-11-29 02:57:16.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1103,'2024-11-29 02:57:16.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1104,'2024-11-29 02:57:16.000','50','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1105,'2024-11-29 02:57:51.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1106,'2024-11-29 02:57:51.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1107,'2024-11-29 02:57:51.000','50','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1108,'2024-11-29 02:57:51.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1109,'2024-11-29 02:57:51.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1110,'2024-11-29 02:58:13.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1111,'2024-11-29 02:58:14.000','62','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1112,'2024-11-29 02:58:14.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1113,'2024-11-29 02:58:14.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1114,'2024-11-29 02:58:14.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1115,'2024-11-29 02:58:58.000','62','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1116,'2024-11-29 02:58:58.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1117,'2024-11-29 02:58:58.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1118,'2024-11-29 02:58:58.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1119,'2024-11-29 02:58:58.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1120,'2024-11-29 03:03:25.000','62','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1121,'2024-11-29 03:03:25.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1122,'2024-11-29 03:03:25.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1123,'2024-11-29 03:03:25.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1124,'2024-11-29 03:03:25.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1125,'2024-11-29 03:03:35.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1126,'2024-11-29 03:03:36.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1127,'2024-11-29 03:03:36.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1128,'2024-11-29 03:03:36.000','62','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1129,'2024-11-29 03:03:36.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1130,'2024-11-29 03:03:55.000','21','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1131,'2024-11-29 03:03:55.000','23','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1132,'2024-11-29 03:03:55.000','3','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1133,'2024-11-29 03:03:56.000','3','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1134,'2024-11-29 03:03:56.000','62','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1135,'2024-11-29 03:20:22.000','1','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1136,'2024-11-29 03:20:22.000','1','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1137,'2024-11-29 03:20:22.000','1','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1138,'2024-11-29 03:20:23.000','1','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1139,'2024-11-29 03:20:23.000','1','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1140,'2024-11-29 03:21:26.000','1','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1141,'2024-11-29 03:21:26.000','1','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1142,'2024-11-29 03:21:26.000','1','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1143,'2024-11-29 03:21:26.000','1','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1144,'2024-11-29 03:21:26.000','1','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1145,'2024-11-29 03:21:39.000','1','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1146,'2024-11-29 03:21:39.000','1','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1147,'2024-11-29 03:21:39.000','1','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1148,'2024-11-29 03:21:39.000','1','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1149,'2024-11-29 03:21:39.000','1','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1150,'2024-11-29 05:56:43.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1151,'2024-11-29 05:56:43.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1152,'2024-11-29 05:56:43.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1153,'2024-11-29 05:56:44.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1154,'2024-11-29 05:56:44.000','2','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1155,'2024-11-29 05:57:18.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1156,'2024-11-29 05:57:19.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1157,'2024-11-29 05:57:19.000','2','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1158,'2024-11-29 05:57:19.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1159,'2024-11-29 05:57:19.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1160,'2024-11-29 05:57:45.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1161,'2024-11-29 05:57:45.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1162,'2024-11-29 05:57:46.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1163,'2024-11-29 05:57:46.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1164,'2024-11-29 05:57:46.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1165,'2024-11-29 08:09:31.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1166,'2024-11-29 08:09:31.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1167,'2024-11-29 08:09:31.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1168,'2024-11-29 08:09:31.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1169,'2024-11-29 08:09:31.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1170,'2024-11-29 08:11:24.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1171,'2024-11-29 08:11:24.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1172,'2024-11-29 08:11:24.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1173,'2024-11-29 08:11:25.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1174,'2024-11-29 08:11:25.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1175,'2024-11-29 08:11:31.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1176,'2024-11-29 08:11:31.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1177,'2024-11-29 08:11:31.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1178,'2024-11-29 08:11:32.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1179,'2024-11-29 08:11:32.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1180,'2024-11-29 09:15:59.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1181,'2024-11-29 09:15:59.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1182,'2024-11-29 09:16:00.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1183,'2024-11-29 09:16:00.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1184,'2024-11-29 09:16:00.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1185,'2024-11-29 09:21:50.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1186,'2024-11-29 09:21:50.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1187,'2024-11-29 09:21:50.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1188,'2024-11-29 09:21:50.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1189,'2024-11-29 09:21:51.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1190,'2024-11-29 09:22:12.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1191,'2024-11-29 09:22:12.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1192,'2024-11-29 09:22:12.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1193,'2024-11-29 09:22:12.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1194,'2024-11-29 09:22:12.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1195,'2024-11-29 09:22:21.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1196,'2024-11-29 09:22:21.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1197,'2024-11-29 09:22:22.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1198,'2024-11-29 09:22:22.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1199,'2024-11-29 09:22:22.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1200,'2024-11-29 09:22:35.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1201,'2024-11-29 09:22:35.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1202,'2024-11-29 09:22:35.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1203,'2024-11-29 09:22:35.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1204,'2024-11-29 09:22:36.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1205,'2024-11-29 09:23:57.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1206,'2024-11-29 09:23:58.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1207,'2024-11-29 09:23:58.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1208,'2024-11-29 09:23:58.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1209,'2024-11-29 09:23:58.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1210,'2024-11-29 09:24:01.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1211,'2024-11-29 09:24:01.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1212,'2024-11-29 09:24:01.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1213,'2024-11-29 09:24:01.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1214,'2024-11-29 09:24:02.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1215,'2024-11-29 09:24:03.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1216,'2024-11-29 09:24:03.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1217,'2024-11-29 09:24:03.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1218,'2024-11-29 09:24:03.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1219,'2024-11-29 09:24:03.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1220,'2024-11-29 09:24:07.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1221,'2024-11-29 09:24:07.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1222,'2024-11-29 09:24:07.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1223,'2024-11-29 09:24:07.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1224,'2024-11-29 09:24:08.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1225,'2024-11-29 11:44:45.000','14','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1226,'2024-11-29 11:44:45.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1227,'2024-11-29 11:44:45.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1228,'2024-11-29 11:44:45.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1229,'2024-11-29 11:44:46.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1230,'2024-11-29 11:45:10.000','2','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1231,'2024-11-29 11:45:10.000','2','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1232,'2024-11-29 11:45:10.000','2','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1233,'2024-11-29 11:45:10.000','2','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1234,'2024-11-29 11:45:11.000','2','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1235,'2024-12-01 06:28:31.000','1','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1236,'2024-12-01 06:28:31.000','1','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1237,'2024-12-01 06:28:31.000','1','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1238,'2024-12-01 06:28:31.000','1','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1239,'2024-12-01 06:28:32.000','1','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1243,'2024-12-01 11:45:16.000','1','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1244,'2024-12-01 11:45:16.000','1','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1423,'2024-12-02 11:13:38.000','80','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1424,'2024-12-02 11:13:38.000','90','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1425,'2024-12-02 11:13:39.000','210','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1426,'2024-12-02 11:13:39.000','120','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1427,'2024-12-02 11:13:39.000','20','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1428,'2024-12-02 11:13:39.000','50','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1429,'2024-12-02 11:13:39.000','60','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1430,'2024-12-02 11:14:46.000','20','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1431,'2024-12-02 11:14:46.000','50','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1432,'2024-12-02 11:14:46.000','60','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1433,'2024-12-02 11:14:47.000','20','1fd43a9f-8287-4ea4-8ab6-ccb6257fddc4','N'),(1434,'2024-12-02 11:14:47.000','80','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1435,'2024-12-02 11:14:47.000','90','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1436,'2024-12-02 11:14:47.000','21','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1437,'2024-12-02 11:14:47.000','120','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1438,'2024-12-02 11:16:04.000','10','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1439,'2024-12-02 11:16:04.000','2','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1440,'2024-12-02 11:16:04.000','5','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1441,'2024-12-02 11:16:05.000','6','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1442,'2024-12-02 11:16:05.000','2','1fd43a9f-8287-4ea4-8ab6-ccb6257fddc4','N'),(1443,'2024-12-02 11:16:05.000','8','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1444,'2024-12-02 11:16:05.000','9','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1445,'2024-12-02 11:16:05.000','2','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1446,'2024-12-02 11:18:22.000','9','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1447,'2024-12-02 11:18:22.000','2','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1448,'2024-12-02 11:18:22.000','10','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1449,'2024-12-02 11:18:22.000','2','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1450,'2024-12-02 11:18:22.000','5','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1451,'2024-12-02 11:18:22.000','6','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1452,'2024-12-02 11:18:22.000','2','1fd43a9f-8287-4ea4-8ab6-ccb6257fddc4','N'),(1453,'2024-12-02 11:18:23.000','8','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1454,'2024-12-02 11:18:23.000','5','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1455,'2024-12-02 11:18:23.000','6','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1456,'2024-12-02 11:18:23.000','2','1fd43a9f-8287-4ea4-8ab6-ccb6257fddc4','N'),(1457,'2024-12-02 11:18:23.000','8','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1458,'2024-12-02 11:18:23.000','9','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1459,'2024-12-02 11:18:23.000','2','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1460,'2024-12-02 11:18:24.000','10','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1461,'2024-12-02 11:18:24.000','2','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1462,'2024-12-02 11:20:21.000','2','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1463,'2024-12-02 11:20:21.000','10','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1464,'2024-12-02 11:21:23.000','10','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1465,'2024-12-02 11:21:23.000','2','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1466,'2024-12-02 11:21:24.000','10','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1467,'2024-12-02 11:21:24.000','2','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1468,'2024-12-02 11:21:24.000','2','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1469,'2024-12-02 11:21:24.000','10','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1470,'2024-12-02 11:21:38.000','10','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1471,'2024-12-02 11:21:38.000','2','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1472,'2024-12-02 11:22:40.000','2','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1473,'2024-12-02 11:22:40.000','10','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1474,'2024-12-02 11:26:00.000','10','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1475,'2024-12-02 11:26:00.000','2','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1476,'2024-12-02 11:26:44.000','150','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1477,'2024-12-02 11:26:44.000','153','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1478,'2024-12-02 11:30:45.000','12','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1479,'2024-12-02 11:30:45.000','153','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1480,'2024-12-02 11:31:12.000','152','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1481,'2024-12-02 11:31:12.000','153','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1482,'2024-12-02 11:31:15.000','153','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1483,'2024-12-02 11:31:15.000','152','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1484,'2024-12-02 11:42:15.000','3352','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1485,'2024-12-02 11:42:15.000','153','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1486,'2024-12-02 11:42:24.000','3352','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1487,'2024-12-02 11:42:24.000','153','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1488,'2024-12-02 11:42:24.000','153','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1489,'2024-12-02 11:42:24.000','3352','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1490,'2024-12-02 11:42:25.000','3352','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1491,'2024-12-02 11:42:25.000','153','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1492,'2024-12-02 11:42:52.000','3352','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1493,'2024-12-02 11:42:52.000','3352','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1494,'2024-12-02 12:09:31.000','56','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1495,'2024-12-02 12:09:31.000','55','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1496,'2024-12-02 12:09:31.000','22','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1497,'2024-12-02 12:09:31.000','12','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(1498,'2024-12-02 12:09:31.000','33','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1499,'2024-12-02 12:09:31.000','52','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1500,'2024-12-02 12:09:31.000','34','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1501,'2024-12-02 12:09:34.000','33','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1502,'2024-12-02 12:09:34.000','52','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1503,'2024-12-02 12:09:34.000','34','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1504,'2024-12-02 12:09:34.000','56','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1505,'2024-12-02 12:09:34.000','55','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1506,'2024-12-02 12:09:35.000','22','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1507,'2024-12-02 12:09:35.000','12','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(1508,'2024-12-02 12:10:07.000','33','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1509,'2024-12-02 12:10:07.000','52','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1510,'2024-12-02 12:10:08.000','34','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1511,'2024-12-02 12:10:08.000','56','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1512,'2024-12-02 12:10:08.000','55','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1513,'2024-12-02 12:10:08.000','22','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1514,'2024-12-02 12:10:08.000','12','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(1515,'2024-12-02 12:10:49.000','34','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1516,'2024-12-02 12:10:49.000','56','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1517,'2024-12-02 12:10:49.000','55','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1518,'2024-12-02 12:10:49.000','22','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1519,'2024-12-02 12:10:50.000','12','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(1520,'2024-12-02 12:10:50.000','33','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1521,'2024-12-02 12:10:50.000','52','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1522,'2024-12-02 12:10:50.000','22','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1523,'2024-12-02 12:10:51.000','12','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(1524,'2024-12-02 12:10:51.000','33','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1525,'2024-12-02 12:10:51.000','52','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1526,'2024-12-02 12:10:51.000','34','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1527,'2024-12-02 12:10:51.000','56','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1528,'2024-12-02 12:10:51.000','55','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1529,'2024-12-02 12:10:55.000','156','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1530,'2024-12-02 12:10:55.000','55','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1531,'2024-12-02 12:10:55.000','22','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1532,'2024-12-02 12:10:55.000','12','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(1533,'2024-12-02 12:10:55.000','33','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1534,'2024-12-02 12:10:55.000','52','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1535,'2024-12-02 12:10:56.000','34','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1536,'2024-12-02 12:11:06.000','33','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1537,'2024-12-02 12:11:06.000','52','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1538,'2024-12-02 12:11:06.000','34','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1539,'2024-12-02 12:11:06.000','152','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1540,'2024-12-02 12:11:06.000','55','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1541,'2024-12-02 12:11:06.000','22','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1542,'2024-12-02 12:11:07.000','12','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(1543,'2024-12-02 12:11:16.000','55','a43d406f-4f7d-40b1-940d-eef3de344255','N'),(1544,'2024-12-02 12:11:16.000','22','9cfea2b3-aff3-4e4c-82c5-8f711828a4a3','N'),(1545,'2024-12-02 12:11:16.000','12','9b50cd5f-9fb3-46ee-9c06-743e560b125c','N'),(1546,'2024-12-02 12:11:17.000','33','183bc2b7-7c82-4d90-9910-858a673c9007','N'),(1547,'2024-12-02 12:11:17.000','52','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1548,'2024-12-02 12:11:17.000','34','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1549,'2024-12-02 12:11:17.000','152','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1550,'2024-12-02 12:11:42.000','12','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1551,'2024-12-02 12:11:44.000','12','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1552,'2024-12-02 12:11:46.000','12','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1553,'2024-12-02 12:12:07.000','45','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1554,'2024-12-02 12:12:09.000','45','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1555,'2024-12-02 12:12:10.000','45','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1556,'2024-12-02 12:12:12.000','45','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1557,'2024-12-02 12:12:13.000','45','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1558,'2024-12-02 12:12:15.000','45','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1559,'2024-12-02 12:12:16.000','45','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1560,'2024-12-02 12:12:17.000','45','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1561,'2024-12-02 12:12:27.000','60','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1562,'2024-12-02 12:15:31.000','60','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1563,'2024-12-02 12:16:11.000','1','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1564,'2024-12-02 12:17:38.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1565,'2024-12-02 12:19:31.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1566,'2024-12-02 12:19:33.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1567,'2024-12-02 12:19:34.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1568,'2024-12-02 12:19:35.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1569,'2024-12-02 12:19:36.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1570,'2024-12-02 12:19:37.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1571,'2024-12-02 12:19:38.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1572,'2024-12-02 12:19:39.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1573,'2024-12-02 12:19:40.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1574,'2024-12-02 12:19:40.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1575,'2024-12-02 12:19:41.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1576,'2024-12-02 12:19:42.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1577,'2024-12-02 12:19:43.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1578,'2024-12-02 12:19:43.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1579,'2024-12-02 12:19:44.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1580,'2024-12-02 12:19:45.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1581,'2024-12-02 12:19:46.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1582,'2024-12-02 12:19:47.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1583,'2024-12-02 12:19:48.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1584,'2024-12-02 12:19:49.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1585,'2024-12-02 12:19:50.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1586,'2024-12-02 12:19:50.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1587,'2024-12-02 12:19:51.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1588,'2024-12-02 12:19:51.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1589,'2024-12-02 12:19:52.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1590,'2024-12-02 12:19:52.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1591,'2024-12-02 12:19:53.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1592,'2024-12-02 12:19:53.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1593,'2024-12-02 12:19:54.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1594,'2024-12-02 12:19:55.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1595,'2024-12-02 12:19:55.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1596,'2024-12-02 12:19:56.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1597,'2024-12-02 12:19:56.000','4','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1598,'2024-12-02 12:21:42.000','5','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1599,'2024-12-02 12:22:42.000','5','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1600,'2024-12-02 12:30:48.000','5','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1601,'2024-12-02 12:30:53.000','5','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1602,'2024-12-02 12:38:53.000','5','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1603,'2024-12-02 12:45:46.000','20','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1604,'2024-12-02 12:46:01.000','28','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1605,'2024-12-02 12:49:32.000','300','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1606,'2024-12-02 12:49:40.000','32','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1607,'2024-12-02 12:49:46.000','34','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1608,'2024-12-02 12:49:50.000','39','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1609,'2024-12-02 12:49:55.000','41','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1610,'2024-12-03 03:42:36.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1611,'2024-12-03 03:42:36.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1612,'2024-12-03 03:42:36.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1613,'2024-12-03 03:42:36.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1614,'2024-12-03 03:42:36.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1615,'2024-12-03 03:42:36.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1616,'2024-12-03 03:42:37.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1617,'2024-12-03 03:42:37.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1618,'2024-12-03 03:42:37.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1619,'2024-12-03 03:42:37.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1620,'2024-12-03 03:42:37.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1621,'2024-12-03 03:42:37.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1622,'2024-12-03 03:42:38.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1623,'2024-12-03 03:42:38.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1624,'2024-12-03 03:42:38.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1625,'2024-12-03 03:42:38.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1626,'2024-12-03 03:42:38.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1627,'2024-12-03 03:42:38.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1628,'2024-12-03 03:42:38.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1629,'2024-12-03 03:42:39.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1630,'2024-12-03 03:42:39.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1631,'2024-12-03 03:42:39.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1632,'2024-12-03 03:42:39.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1633,'2024-12-03 03:42:39.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1634,'2024-12-03 03:42:39.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1635,'2024-12-03 03:42:39.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1636,'2024-12-03 03:42:40.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1637,'2024-12-03 03:42:40.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1638,'2024-12-03 03:55:02.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1639,'2024-12-03 03:55:02.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1640,'2024-12-03 03:55:02.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1641,'2024-12-03 03:55:02.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1642,'2024-12-03 03:55:02.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1643,'2024-12-03 03:55:02.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1644,'2024-12-03 03:55:02.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1645,'2024-12-03 03:55:03.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1646,'2024-12-03 03:55:03.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1647,'2024-12-03 03:55:03.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1648,'2024-12-03 03:55:03.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1649,'2024-12-03 03:55:03.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1650,'2024-12-03 03:55:03.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1651,'2024-12-03 03:55:04.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1652,'2024-12-03 04:27:33.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1653,'2024-12-03 04:27:33.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1654,'2024-12-03 04:27:33.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1655,'2024-12-03 04:27:34.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1656,'2024-12-03 04:27:34.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1657,'2024-12-03 04:27:34.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1658,'2024-12-03 04:27:34.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1659,'2024-12-03 04:27:34.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1660,'2024-12-03 04:27:34.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1661,'2024-12-03 04:27:34.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1662,'2024-12-03 04:27:35.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1663,'2024-12-03 04:27:35.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1664,'2024-12-03 04:27:35.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1665,'2024-12-03 04:27:35.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1666,'2024-12-03 04:27:35.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1667,'2024-12-03 04:27:35.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1668,'2024-12-03 04:27:35.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1669,'2024-12-03 04:27:36.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1670,'2024-12-03 04:27:36.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1671,'2024-12-03 04:27:36.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1672,'2024-12-03 04:27:36.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1673,'2024-12-03 04:30:49.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1674,'2024-12-03 04:30:49.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1675,'2024-12-03 04:30:49.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1676,'2024-12-03 04:30:49.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1677,'2024-12-03 04:30:49.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1678,'2024-12-03 04:30:49.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1679,'2024-12-03 04:30:49.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1680,'2024-12-03 04:30:50.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1681,'2024-12-03 04:30:50.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1682,'2024-12-03 04:30:50.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1683,'2024-12-03 04:30:50.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1684,'2024-12-03 04:30:50.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1685,'2024-12-03 04:30:50.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1686,'2024-12-03 04:30:51.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1687,'2024-12-03 04:30:51.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1688,'2024-12-03 04:30:51.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1689,'2024-12-03 04:30:51.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1690,'2024-12-03 04:30:51.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1691,'2024-12-03 04:30:51.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1692,'2024-12-03 04:30:51.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1693,'2024-12-03 04:30:52.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1694,'2024-12-03 04:41:35.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1695,'2024-12-03 04:41:35.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1696,'2024-12-03 04:41:35.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1697,'2024-12-03 04:41:36.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1698,'2024-12-03 04:41:36.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1699,'2024-12-03 04:41:36.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1700,'2024-12-03 04:41:36.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1701,'2024-12-03 04:41:36.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1702,'2024-12-03 04:41:36.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1703,'2024-12-03 04:41:37.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1704,'2024-12-03 04:41:37.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1705,'2024-12-03 04:41:37.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1706,'2024-12-03 04:41:37.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1707,'2024-12-03 04:41:37.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1708,'2024-12-03 04:41:37.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1709,'2024-12-03 04:41:38.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1710,'2024-12-03 04:41:38.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1711,'2024-12-03 04:41:38.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1712,'2024-12-03 04:41:38.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1713,'2024-12-03 04:41:38.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1714,'2024-12-03 04:41:38.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1715,'2024-12-03 05:08:17.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1716,'2024-12-03 05:08:17.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1717,'2024-12-03 05:08:17.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1718,'2024-12-03 05:08:18.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1719,'2024-12-03 05:08:18.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1720,'2024-12-03 05:08:18.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1721,'2024-12-03 05:08:18.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1722,'2024-12-03 05:08:19.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1723,'2024-12-03 05:08:19.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1724,'2024-12-03 05:08:19.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1725,'2024-12-03 05:08:19.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1726,'2024-12-03 05:08:20.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1727,'2024-12-03 05:08:20.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1728,'2024-12-03 05:08:20.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1729,'2024-12-03 05:08:36.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1730,'2024-12-03 05:08:36.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1731,'2024-12-03 05:08:36.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1732,'2024-12-03 05:08:36.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1733,'2024-12-03 05:08:37.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1734,'2024-12-03 05:08:37.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1735,'2024-12-03 05:08:37.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1736,'2024-12-03 05:08:38.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1737,'2024-12-03 05:08:38.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1738,'2024-12-03 05:08:38.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1739,'2024-12-03 05:08:38.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1740,'2024-12-03 05:08:38.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1741,'2024-12-03 05:08:38.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1742,'2024-12-03 05:08:38.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1743,'2024-12-03 05:08:39.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1744,'2024-12-03 05:08:39.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1745,'2024-12-03 05:08:39.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1746,'2024-12-03 05:08:40.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1747,'2024-12-03 05:08:40.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1748,'2024-12-03 05:08:40.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1749,'2024-12-03 05:08:40.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1750,'2024-12-03 05:08:49.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1751,'2024-12-03 05:08:49.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1752,'2024-12-03 05:08:49.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1753,'2024-12-03 05:08:49.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1754,'2024-12-03 05:08:49.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1755,'2024-12-03 05:08:50.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1756,'2024-12-03 05:08:50.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1757,'2024-12-03 05:08:50.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1758,'2024-12-03 05:08:50.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1759,'2024-12-03 05:08:50.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1760,'2024-12-03 05:08:51.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1761,'2024-12-03 05:08:51.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1762,'2024-12-03 05:08:51.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1763,'2024-12-03 05:08:51.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1764,'2024-12-03 05:08:52.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1765,'2024-12-03 05:08:52.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1766,'2024-12-03 05:08:52.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1767,'2024-12-03 05:08:52.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1768,'2024-12-03 05:08:52.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1769,'2024-12-03 05:08:53.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1770,'2024-12-03 05:08:53.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1771,'2024-12-03 05:08:53.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1772,'2024-12-03 05:08:53.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1773,'2024-12-03 05:08:53.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1774,'2024-12-03 05:08:53.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1775,'2024-12-03 05:08:53.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1776,'2024-12-03 05:08:54.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1777,'2024-12-03 05:08:54.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1778,'2024-12-03 05:09:06.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1779,'2024-12-03 05:09:06.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1780,'2024-12-03 05:09:07.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1781,'2024-12-03 05:09:07.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1782,'2024-12-03 05:09:07.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1783,'2024-12-03 05:09:07.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1784,'2024-12-03 05:09:07.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1785,'2024-12-03 05:09:24.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1786,'2024-12-03 05:09:24.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1787,'2024-12-03 05:09:24.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1788,'2024-12-03 05:09:24.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1789,'2024-12-03 05:09:24.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1790,'2024-12-03 05:09:24.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1791,'2024-12-03 05:09:25.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1792,'2024-12-03 05:09:25.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1793,'2024-12-03 05:09:26.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1794,'2024-12-03 05:09:26.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1795,'2024-12-03 05:09:26.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1796,'2024-12-03 05:09:26.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1797,'2024-12-03 05:09:26.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1798,'2024-12-03 05:09:26.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1799,'2024-12-03 05:09:26.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1800,'2024-12-03 05:09:27.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1801,'2024-12-03 05:09:27.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1802,'2024-12-03 05:09:27.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1803,'2024-12-03 05:09:27.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1804,'2024-12-03 05:09:27.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1805,'2024-12-03 05:09:27.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1806,'2024-12-03 05:09:28.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1807,'2024-12-03 05:09:28.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1808,'2024-12-03 05:09:28.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1809,'2024-12-03 05:09:28.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1810,'2024-12-03 05:09:28.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1811,'2024-12-03 05:09:28.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1812,'2024-12-03 05:09:28.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1813,'2024-12-03 05:09:29.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1814,'2024-12-03 05:09:29.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1815,'2024-12-03 05:09:29.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1816,'2024-12-03 05:09:29.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1817,'2024-12-03 05:09:29.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1818,'2024-12-03 05:09:29.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1819,'2024-12-03 05:09:30.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1820,'2024-12-03 05:09:31.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1821,'2024-12-03 05:09:31.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1822,'2024-12-03 05:09:31.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1823,'2024-12-03 05:09:31.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1824,'2024-12-03 05:09:31.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1825,'2024-12-03 05:09:32.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1826,'2024-12-03 05:09:32.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1827,'2024-12-03 05:09:34.000','42','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1828,'2024-12-03 05:09:34.000','42','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1829,'2024-12-03 05:09:34.000','42','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1830,'2024-12-03 05:09:34.000','42','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1831,'2024-12-03 05:09:34.000','42','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1832,'2024-12-03 05:09:34.000','42','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1833,'2024-12-03 05:09:34.000','42','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1834,'2024-12-03 05:09:48.000','4','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1835,'2024-12-03 05:09:48.000','4','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1836,'2024-12-03 05:09:48.000','4','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1837,'2024-12-03 05:09:48.000','2','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1838,'2024-12-03 05:09:48.000','2','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1839,'2024-12-03 05:09:48.000','4','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1840,'2024-12-03 05:09:49.000','2','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1841,'2024-12-03 05:09:53.000','4','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1842,'2024-12-03 05:09:53.000','2','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1843,'2024-12-03 05:09:54.000','2','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1844,'2024-12-03 05:09:54.000','4','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1845,'2024-12-03 05:09:54.000','2','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1846,'2024-12-03 05:09:54.000','4','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1847,'2024-12-03 05:09:54.000','4','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1848,'2024-12-03 05:09:58.000','2','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1849,'2024-12-03 05:09:58.000','4','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1850,'2024-12-03 05:09:58.000','4','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1851,'2024-12-03 05:09:58.000','4','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1852,'2024-12-03 05:09:58.000','2','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1853,'2024-12-03 05:09:58.000','2','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1854,'2024-12-03 05:09:59.000','4','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1855,'2024-12-03 05:09:59.000','4','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1856,'2024-12-03 05:09:59.000','2','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1857,'2024-12-03 05:09:59.000','4','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1858,'2024-12-03 05:10:00.000','4','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1859,'2024-12-03 05:10:00.000','4','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1860,'2024-12-03 05:10:00.000','2','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1861,'2024-12-03 05:10:00.000','2','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1862,'2024-12-03 05:10:00.000','4','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1863,'2024-12-03 05:10:00.000','4','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1864,'2024-12-03 05:10:00.000','4','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1865,'2024-12-03 05:10:01.000','2','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1866,'2024-12-03 05:10:01.000','2','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1867,'2024-12-03 05:10:01.000','4','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1868,'2024-12-03 05:10:01.000','2','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1869,'2024-12-03 05:10:01.000','2','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1870,'2024-12-03 05:10:01.000','4','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1871,'2024-12-03 05:10:02.000','2','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1872,'2024-12-03 05:10:02.000','4','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1873,'2024-12-03 05:10:02.000','4','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1874,'2024-12-03 05:10:02.000','4','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1875,'2024-12-03 05:10:02.000','2','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1876,'2024-12-03 05:10:04.000','4','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1877,'2024-12-03 05:10:04.000','2','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1878,'2024-12-03 05:10:05.000','4','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1879,'2024-12-03 05:10:05.000','4','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1880,'2024-12-03 05:10:05.000','4','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1881,'2024-12-03 05:10:05.000','2','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1882,'2024-12-03 05:10:05.000','2','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1883,'2024-12-03 05:10:44.000','2','f9a98031-5eb9-42c4-bfb9-c4f66945d8c3','N'),(1884,'2024-12-03 05:10:44.000','2','46f9cd44-ca56-434b-a5d7-088ffbe892d0','N'),(1885,'2024-12-03 05:10:44.000','4','5e84d353-ec10-461a-a8ef-b01e8d6bcdef','N'),(1886,'2024-12-03 05:10:44.000','2','055a7ed9-85ee-4bd2-af06-ae8c9a6aa9d5','N'),(1887,'2024-12-03 05:10:44.000','4','131fad4b-d073-4ab7-9d2a-e2edd0e10fbb','N'),(1888,'2024-12-03 05:10:45.000','4','9a6f2762-a5c9-480e-9fcd-8d8144eda7da','N'),(1889,'2024-12-03 05:10:45.000','4','301cf7e4-df3b-45e8-9219-970b52a33a25','N'),(1890,'2024-12-04 07:07:13.000','32','544b8734-5609-42ed-8a03-7222cb65984d','N'),(1891,'2024-12-04 07:07:14.000','29','623a9ecb-a9db-4d6f-ae35-d83fc13a4390','N'),(1892,'2024-12-04 07:07:14.000','23','cabd6a44-2798-4120-a5cd-2b0fbce91869','N'),(1893,'2024-12-04 07:17:28.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1894,'2024-12-04 07:17:28.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1895,'2024-12-04 07:17:29.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1896,'2024-12-04 07:17:29.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1897,'2024-12-04 07:17:29.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1898,'2024-12-04 07:35:19.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1899,'2024-12-04 07:35:19.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1900,'2024-12-04 07:35:19.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1901,'2024-12-04 07:35:19.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1902,'2024-12-04 07:35:20.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1903,'2024-12-04 09:03:35.000','32','0c9e298d-f33a-4c28-ab66-70c353d01316','N'),(1904,'2024-12-04 09:03:35.000','22','1ba601be-99d2-4b02-8c11-46cda878ab9d','N'),(1905,'2024-12-05 03:21:38.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1906,'2024-12-05 03:21:39.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1907,'2024-12-05 03:21:39.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1908,'2024-12-05 03:21:39.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1909,'2024-12-05 03:21:39.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1910,'2024-12-05 03:24:40.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1911,'2024-12-05 03:24:40.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1912,'2024-12-05 03:24:40.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1913,'2024-12-05 03:24:41.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1914,'2024-12-05 03:24:41.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1915,'2024-12-05 03:27:06.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1916,'2024-12-05 03:27:06.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1917,'2024-12-05 03:27:06.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1918,'2024-12-05 03:27:06.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1919,'2024-12-05 03:27:06.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1920,'2024-12-05 03:27:48.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1921,'2024-12-05 03:27:48.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1922,'2024-12-05 03:27:48.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1923,'2024-12-05 03:27:48.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1924,'2024-12-05 03:27:48.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1925,'2024-12-05 05:03:14.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1926,'2024-12-05 05:03:14.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1927,'2024-12-05 05:03:14.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1928,'2024-12-05 05:03:14.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1929,'2024-12-05 05:03:14.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1930,'2024-12-05 08:52:16.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1931,'2024-12-05 08:52:16.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1932,'2024-12-05 08:52:16.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1933,'2024-12-05 08:52:16.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1934,'2024-12-05 08:52:16.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1935,'2024-12-05 08:52:26.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1936,'2024-12-05 08:52:26.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1937,'2024-12-05 08:52:26.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1938,'2024-12-05 08:52:26.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1939,'2024-12-05 08:52:26.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1940,'2024-12-05 09:04:47.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1941,'2024-12-05 09:04:47.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1942,'2024-12-05 09:04:48.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1943,'2024-12-05 09:04:48.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1944,'2024-12-05 09:04:48.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1945,'2024-12-05 09:04:57.000','24','820bfada-05dc-4f98-9966-a35e984219d0','N'),(1946,'2024-12-05 09:04:57.000','21','83aed412-4e3b-4da6-8c65-f3002d4cec5b','N'),(1947,'2024-12-05 09:04:57.000','12','bddb3ab0-3fe7-40eb-8f57-05becbc79833','N'),(1948,'2024-12-05 09:04:57.000','12','c2733ffc-3fdb-4df1-b99a-37ee097a5d5a','N'),(1949,'2024-12-05 09:04:57.000','23','31575641-b9c7-492c-a05d-1c5ef3520ca0','N'),(1950,'2024-12-06 06:19:13.000','2','f5c4c5fb-d913-428f-9507-1caa49667b87','N'),(1951,'2024-12-06 06:19:27.000','2.67','f5c4c5fb-d913-428f-9507-1caa49667b87','N');